@@ -281,6 +281,102 @@ func canonicalPolicy(src string) (interface{}, error) {
 	return policy, nil
 }
 
+// Condition keys that limit a statement to known principals or networks when
+// compared to fixed values, following the rules S3 uses to decide whether a
+// policy is public.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/access-control-block-public-access.html#access-control-block-public-access-policy-status
+var nonPublicPolicyConditionKeys = map[string]bool{
+	"aws:principalaccount":      true,
+	"aws:principalarn":          true,
+	"aws:principalorgid":        true,
+	"aws:sourceaccount":         true,
+	"aws:sourcearn":             true,
+	"aws:sourceip":              true,
+	"aws:sourceowner":           true,
+	"aws:sourcevpc":             true,
+	"aws:sourcevpce":            true,
+	"aws:userid":                true,
+	"s3:dataaccesspointaccount": true,
+	"s3:dataaccesspointarn":     true,
+}
+
+// policyPublicStatements evaluates a (unescaped) resource policy locally and
+// returns the Sid, or the position if there is none, of each statement that
+// allows access to anyone. A statement is public if it allows a wildcard
+// principal, or uses NotPrincipal, without a condition that limits it to fixed
+// accounts, ARNs, networks or access points.
+func policyPublicStatements(src string) ([]string, error) {
+	if src == "" {
+		return nil, nil
+	}
+
+	var policy Policy
+	if err := json.Unmarshal([]byte(src), &policy); err != nil {
+		return nil, fmt.Errorf("Convert policy failed unmarshalling source data: %+v.  src: %s", err, url.QueryEscape(src))
+	}
+
+	publicStatements := []string{}
+	for i, statement := range policy.Statements {
+		if statement.Effect != "Allow" || !statementHasPublicPrincipal(statement) || statementHasNonPublicCondition(statement) {
+			continue
+		}
+		if statement.Sid != "" {
+			publicStatements = append(publicStatements, statement.Sid)
+		} else {
+			publicStatements = append(publicStatements, fmt.Sprintf("Statement[%d]", i))
+		}
+	}
+
+	return publicStatements, nil
+}
+
+func statementHasPublicPrincipal(statement Statement) bool {
+	// NotPrincipal with Allow grants access to everyone except the listed principals
+	if len(statement.NotPrincipal) > 0 {
+		return true
+	}
+	for _, values := range statement.Principal {
+		for _, value := range values.([]string) {
+			if strings.Contains(value, "*") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func statementHasNonPublicCondition(statement Statement) bool {
+	for operator, condition := range statement.Condition {
+		// IfExists and ForAllValues conditions pass when the key is missing from
+		// the request, so they don't limit who has access
+		operator = strings.TrimPrefix(operator, "ForAnyValue:")
+		if strings.HasSuffix(operator, "IfExists") || strings.HasPrefix(operator, "ForAllValues:") {
+			continue
+		}
+		switch operator {
+		case "StringEquals", "StringEqualsIgnoreCase", "StringLike", "ArnEquals", "ArnLike", "IpAddress":
+		default:
+			continue
+		}
+
+		for key, values := range condition.(map[string]interface{}) {
+			if !nonPublicPolicyConditionKeys[key] {
+				continue
+			}
+			fixed := true
+			for _, value := range values.([]string) {
+				if strings.Contains(value, "*") || value == "0.0.0.0/0" || value == "::/0" {
+					fixed = false
+				}
+			}
+			if fixed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 //// UTILITY FUNCTIONS
 
 // toSliceOfStrings converts a string or array value to an array of strings
@@ -263,6 +263,43 @@ func TestConvertPolicy(t *testing.T) {
 
 }
 
+func TestPolicyPublicStatements(t *testing.T) {
+	testCases := map[string][]string{
+		// public principal, single statement
+		`{"Version": "2012-10-17", "Statement": {"Sid": "PublicRead", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*"}}`: {"PublicRead"},
+		// wildcard account and NotPrincipal, without Sid
+		`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::*:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*"},
+			{"Effect": "Allow", "NotPrincipal": {"AWS": "arn:aws:iam::111122223333:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*"}
+		]}`: {"Statement[0]", "Statement[1]"},
+		// deny and fixed principals are not public
+		`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::b/*"},
+			{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::111122223333:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*"},
+			{"Effect": "Allow", "Principal": {"Service": "cloudtrail.amazonaws.com"}, "Action": "s3:PutObject", "Resource": "arn:aws:s3:::b/*"}
+		]}`: {},
+		// fixed conditions limit a public principal, wildcard and IfExists conditions don't
+		`{"Version": "2012-10-17", "Statement": [
+			{"Sid": "Vpce", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"StringEquals": {"aws:SourceVpce": "vpce-1a2b3c4d"}}},
+			{"Sid": "Org", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"StringEquals": {"aws:PrincipalOrgID": ["o-abc123"]}}},
+			{"Sid": "AnyIp", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"IpAddress": {"aws:SourceIp": "0.0.0.0/0"}}},
+			{"Sid": "AnyArn", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"ArnLike": {"aws:SourceArn": "*"}}},
+			{"Sid": "IfExists", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"StringEqualsIfExists": {"aws:SourceAccount": "111122223333"}}},
+			{"Sid": "Tls", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Condition": {"Bool": {"aws:SecureTransport": "true"}}}
+		]}`: {"AnyIp", "AnyArn", "IfExists", "Tls"},
+	}
+
+	for policy, expected := range testCases {
+		statements, err := policyPublicStatements(policy)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fmt.Sprint(statements) != fmt.Sprint(expected) {
+			t.Errorf("expected public statements %v, got %v for policy %s", expected, statements, policy)
+		}
+	}
+}
+
 func prettyPrint(src interface{}) {
 	pretty, err := json.MarshalIndent(src, "", "  ")
 	if err != nil {
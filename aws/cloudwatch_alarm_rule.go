@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/turbot/go-kit/helpers"
+)
+
+// cwAlarmRuleNode is a node of a parsed composite alarm rule. Operators have
+// operands, state functions such as ALARM("name") have a state and an alarm,
+// and TRUE and FALSE have a value. AT_LEAST(2, NOT OK, (a, b, c)) has a
+// threshold, a state and an operand with the alarm of each alarm it checks.
+type cwAlarmRuleNode struct {
+	Operator  string             `json:"operator,omitempty"`
+	Operands  []*cwAlarmRuleNode `json:"operands,omitempty"`
+	Threshold string             `json:"threshold,omitempty"`
+	State     string             `json:"state,omitempty"`
+	Alarm     string             `json:"alarm,omitempty"`
+	Value     *bool              `json:"value,omitempty"`
+}
+
+// cwAlarmRuleStates are the functions checking the state of an alarm.
+var cwAlarmRuleStates = []string{"ALARM", "OK", "INSUFFICIENT_DATA"}
+
+// parseCWAlarmRule parses the rule expression of a composite alarm, e.g.
+// ALARM("CPUTooHigh") AND NOT (ALARM(DiskFull) OR OK("arn:...")). AND binds
+// tighter than OR, and consecutive operands of the same operator are flattened.
+func parseCWAlarmRule(rule string) (*cwAlarmRuleNode, error) {
+	p := &cwAlarmRuleParser{tokens: tokenizeCWAlarmRule(rule)}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in alarm rule", p.tokens[p.pos])
+	}
+	return node, nil
+}
+
+// alarms returns the alarms referenced by the rule, in order and without
+// duplicates.
+func (node *cwAlarmRuleNode) alarms() []string {
+	var alarms []string
+	seen := map[string]bool{}
+	var walk func(*cwAlarmRuleNode)
+	walk = func(n *cwAlarmRuleNode) {
+		if n.Alarm != "" && !seen[n.Alarm] {
+			seen[n.Alarm] = true
+			alarms = append(alarms, n.Alarm)
+		}
+		for _, operand := range n.Operands {
+			walk(operand)
+		}
+	}
+	walk(node)
+	return alarms
+}
+
+// tokenizeCWAlarmRule splits the rule into parentheses, quoted strings and
+// words. Quoted strings keep their quotes to tell them apart from keywords.
+func tokenizeCWAlarmRule(rule string) []string {
+	var tokens []string
+	runes := []rune(rule)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			if j < len(runes) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()\",", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		}
+	}
+	return tokens
+}
+
+type cwAlarmRuleParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *cwAlarmRuleParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *cwAlarmRuleParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *cwAlarmRuleParser) expect(token string) error {
+	if next := p.next(); next != token {
+		return fmt.Errorf("expected %q in alarm rule, got %q", token, next)
+	}
+	return nil
+}
+
+func (p *cwAlarmRuleParser) parseOr() (*cwAlarmRuleNode, error) {
+	return p.parseBinary("OR", p.parseAnd)
+}
+
+func (p *cwAlarmRuleParser) parseAnd() (*cwAlarmRuleNode, error) {
+	return p.parseBinary("AND", p.parseUnary)
+}
+
+func (p *cwAlarmRuleParser) parseBinary(operator string, parseOperand func() (*cwAlarmRuleNode, error)) (*cwAlarmRuleNode, error) {
+	node, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), operator) {
+		p.next()
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if node.Operator != operator {
+			node = &cwAlarmRuleNode{Operator: operator, Operands: []*cwAlarmRuleNode{node}}
+		}
+		node.Operands = append(node.Operands, operand)
+	}
+	return node, nil
+}
+
+func (p *cwAlarmRuleParser) parseUnary() (*cwAlarmRuleNode, error) {
+	if strings.EqualFold(p.peek(), "NOT") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &cwAlarmRuleNode{Operator: "NOT", Operands: []*cwAlarmRuleNode{operand}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *cwAlarmRuleParser) parsePrimary() (*cwAlarmRuleNode, error) {
+	token := p.next()
+	keyword := strings.ToUpper(token)
+
+	switch {
+	case token == "(":
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect(")")
+	case keyword == "TRUE" || keyword == "FALSE":
+		value := keyword == "TRUE"
+		return &cwAlarmRuleNode{Value: &value}, nil
+	case keyword == "AT_LEAST":
+		return p.parseAtLeast()
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of alarm rule")
+	}
+
+	for _, state := range cwAlarmRuleStates {
+		if keyword != state {
+			continue
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		alarm := strings.Trim(p.next(), "\"")
+		if alarm == "" || alarm == ")" {
+			return nil, fmt.Errorf("missing alarm in %s of alarm rule", state)
+		}
+		return &cwAlarmRuleNode{State: state, Alarm: alarm}, p.expect(")")
+	}
+
+	return nil, fmt.Errorf("unexpected %q in alarm rule", token)
+}
+
+// parseAtLeast parses the arguments of AT_LEAST(M, STATE, (alarm, ...)), where
+// M is a number or a percentage of the alarms and STATE is a state, optionally
+// negated with NOT.
+func (p *cwAlarmRuleParser) parseAtLeast() (*cwAlarmRuleNode, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	node := &cwAlarmRuleNode{Operator: "AT_LEAST", Threshold: p.next()}
+	if node.Threshold == "," || node.Threshold == ")" || node.Threshold == "" {
+		return nil, fmt.Errorf("missing threshold in AT_LEAST of alarm rule")
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	state := strings.ToUpper(p.next())
+	if state == "NOT" {
+		state = "NOT " + strings.ToUpper(p.next())
+	}
+	if !helpers.StringSliceContains(cwAlarmRuleStates, strings.TrimPrefix(state, "NOT ")) {
+		return nil, fmt.Errorf("unexpected state %q in AT_LEAST of alarm rule", state)
+	}
+	node.State = state
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		alarm := strings.Trim(p.next(), "\"")
+		if alarm == "" || alarm == "," || alarm == ")" {
+			return nil, fmt.Errorf("missing alarm in AT_LEAST of alarm rule")
+		}
+		node.Operands = append(node.Operands, &cwAlarmRuleNode{Alarm: alarm})
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return node, p.expect(")")
+}
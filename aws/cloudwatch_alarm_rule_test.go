@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseCWAlarmRule(t *testing.T) {
+	rule := `ALARM("CPUTooHigh") AND NOT (ALARM(DiskFull) OR OK("arn:aws:cloudwatch:us-east-1:123456789012:alarm:Deploying")) and ALARM("CPUTooHigh")`
+	node, err := parseCWAlarmRule(rule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, _ := json.Marshal(node)
+	expected := `{"operator":"AND","operands":[{"state":"ALARM","alarm":"CPUTooHigh"},{"operator":"NOT","operands":[{"operator":"OR","operands":[{"state":"ALARM","alarm":"DiskFull"},{"state":"OK","alarm":"arn:aws:cloudwatch:us-east-1:123456789012:alarm:Deploying"}]}]},{"state":"ALARM","alarm":"CPUTooHigh"}]}`
+	if string(parsed) != expected {
+		t.Errorf("unexpected parsed rule %s", parsed)
+	}
+
+	alarms := node.alarms()
+	if !reflect.DeepEqual(alarms, []string{"CPUTooHigh", "DiskFull", "arn:aws:cloudwatch:us-east-1:123456789012:alarm:Deploying"}) {
+		t.Errorf("unexpected alarms %v", alarms)
+	}
+
+	// AND binds tighter than OR
+	node, err = parseCWAlarmRule(`ALARM(a) OR ALARM(b) AND TRUE`)
+	if err != nil || node.Operator != "OR" || node.Operands[1].Operator != "AND" || !*node.Operands[1].Operands[1].Value {
+		t.Errorf("unexpected precedence in %+v: %v", node, err)
+	}
+
+	node, err = parseCWAlarmRule(`ALARM(a) OR AT_LEAST(50%, NOT OK, ("b", arn:aws:cloudwatch:us-east-1:123456789012:alarm:c))`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parsed, _ = json.Marshal(node)
+	expected = `{"operator":"OR","operands":[{"state":"ALARM","alarm":"a"},{"operator":"AT_LEAST","operands":[{"alarm":"b"},{"alarm":"arn:aws:cloudwatch:us-east-1:123456789012:alarm:c"}],"threshold":"50%","state":"NOT OK"}]}`
+	if string(parsed) != expected {
+		t.Errorf("unexpected parsed rule %s", parsed)
+	}
+	if alarms := node.alarms(); !reflect.DeepEqual(alarms, []string{"a", "b", "arn:aws:cloudwatch:us-east-1:123456789012:alarm:c"}) {
+		t.Errorf("unexpected alarms %v", alarms)
+	}
+
+	for _, invalid := range []string{`ALARM(a) AND`, `ALARM(a))`, `ALARM()`, `DISABLED(a)`, `AT_LEAST(2, ALARM, ())`, `AT_LEAST(2, DISABLED, (a))`, `AT_LEAST(2, ALARM, (a)`} {
+		if _, err := parseCWAlarmRule(invalid); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
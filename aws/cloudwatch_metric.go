@@ -3,7 +3,9 @@ package aws
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -11,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 // append the common cloudwatch metric columns onto the column list
@@ -60,6 +63,40 @@ func commonCwMetricColumns() []*plugin.Column {
 			Description: "The standard unit for the data point.",
 			Type:        proto.ColumnType_STRING,
 		},
+		{
+			Name:        "period",
+			Description: "The granularity, in seconds, of the data point.",
+			Type:        proto.ColumnType_INT,
+		},
+		{
+			Name:        "extended_statistics",
+			Description: "The p50, p90, p99 and tm99 percentile statistics for the data point.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "p50",
+			Description: "The 50th percentile of the metric values for the data point.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("ExtendedStatistics.p50"),
+		},
+		{
+			Name:        "p90",
+			Description: "The 90th percentile of the metric values for the data point.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("ExtendedStatistics.p90"),
+		},
+		{
+			Name:        "p99",
+			Description: "The 99th percentile of the metric values for the data point.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("ExtendedStatistics.p99"),
+		},
+		{
+			Name:        "tm99",
+			Description: "The mean of the metric values for the data point, excluding the highest 1%.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("ExtendedStatistics.tm99"),
+		},
 		{
 			Name:        "timestamp",
 			Description: "The time stamp used for the data point.",
@@ -84,8 +121,8 @@ type CWMetricRow struct {
 	// The average of the metric values that correspond to the data point.
 	Average *float64
 
-	// The percentile statistic for the data point.
-	//ExtendedStatistics map[string]*float64 `type:"map"`
+	// The percentile statistics for the data point.
+	ExtendedStatistics map[string]float64
 
 	// The maximum metric value for the data point.
 	Maximum *float64
@@ -100,6 +137,9 @@ type CWMetricRow struct {
 	// The sum of the metric values for the data point.
 	Sum *float64
 
+	// The granularity, in seconds, of the data point.
+	Period *int32
+
 	// The time stamp used for the data point.
 	Timestamp *time.Time
 
@@ -133,7 +173,163 @@ func getCWPeriodForGranularity(granularity string) int32 {
 	return 300
 }
 
-func listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, namespace string, metricName string, dimensionName string, dimensionValue string) (*cloudwatch.GetMetricStatisticsOutput, error) {
+// cwMetricDataMaxQueries is the maximum number of metric queries allowed in a
+// single GetMetricData request.
+const cwMetricDataMaxQueries = 500
+
+// cwMetricDataBatchWait is the longest a batch waits for queries from other
+// resources after its first request before it is sent.
+const cwMetricDataBatchWait = 50 * time.Millisecond
+
+// cwMetricDataBatchIdleWait is how long a batch waits for the next request
+// before it is sent, so it isn't held once the resources stop coming.
+const cwMetricDataBatchIdleWait = 5 * time.Millisecond
+
+// cwMetricStatistics maps the statistic columns to the statistics requested.
+var cwMetricStatistics = map[string]types.Statistic{
+	"average":      types.StatisticAverage,
+	"maximum":      types.StatisticMaximum,
+	"minimum":      types.StatisticMinimum,
+	"sample_count": types.StatisticSampleCount,
+	"sum":          types.StatisticSum,
+}
+
+// cwMetricExtendedStatistics are the percentile statistics returned as columns.
+var cwMetricExtendedStatistics = []string{"p50", "p90", "p99", "tm99"}
+
+// cwMetricKeyColumns are the quals used to set the time range and period of
+// the statistics.
+func cwMetricKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:       "timestamp",
+			Operators:  []string{">", ">=", "=", "<", "<="},
+			Require:    plugin.Optional,
+			CacheMatch: "exact",
+		},
+		{
+			Name:       "period",
+			Require:    plugin.Optional,
+			CacheMatch: "exact",
+		},
+	}
+}
+
+// cwMetricWindow is the time range and period of the statistics. Unset values
+// default to the ones of the table granularity.
+type cwMetricWindow struct {
+	startTime *time.Time
+	endTime   *time.Time
+	period    int32
+}
+
+// getCWMetricWindow returns the time range and period requested by the
+// timestamp and period quals.
+func getCWMetricWindow(d *plugin.QueryData) cwMetricWindow {
+	window := cwMetricWindow{}
+
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			// The end time is exclusive, so include the data point starting at
+			// the timestamp for = and <=
+			switch q.Operator {
+			case "=":
+				window.startTime = aws.Time(timestamp)
+				window.endTime = aws.Time(timestamp.Add(time.Second))
+			case ">=", ">":
+				if window.startTime == nil || timestamp.After(*window.startTime) {
+					window.startTime = aws.Time(timestamp)
+				}
+			case "<=":
+				timestamp = timestamp.Add(time.Second)
+				fallthrough
+			case "<":
+				if window.endTime == nil || timestamp.Before(*window.endTime) {
+					window.endTime = aws.Time(timestamp)
+				}
+			}
+		}
+	}
+
+	if d.EqualsQuals["period"] != nil {
+		window.period = int32(d.EqualsQuals["period"].GetInt64Value())
+	}
+
+	return window
+}
+
+// resolve returns the start time, end time and period, using the defaults of
+// the granularity for the unset values.
+func (window cwMetricWindow) resolve(granularity string) (time.Time, time.Time, int32) {
+	startTime := getCWStartDateForGranularity(granularity)
+	if window.startTime != nil {
+		startTime = *window.startTime
+	}
+	endTime := time.Now()
+	if window.endTime != nil {
+		endTime = *window.endTime
+	}
+	period := getCWPeriodForGranularity(granularity)
+	if window.period != 0 {
+		period = window.period
+	}
+	return startTime, endTime, period
+}
+
+// String returns the requested window, to tell the batches apart. The default
+// values are left out, since they are only known when a batch is sent.
+func (window cwMetricWindow) String() string {
+	var startTime, endTime string
+	if window.startTime != nil {
+		startTime = window.startTime.Format(time.RFC3339)
+	}
+	if window.endTime != nil {
+		endTime = window.endTime.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%s/%s/%d", startTime, endTime, window.period)
+}
+
+// cwMetricDataRequest is the metric statistics requested for a resource. Its
+// rows are set once the batch it belongs to has been sent.
+type cwMetricDataRequest struct {
+	ctx            context.Context
+	d              *plugin.QueryData
+	namespace      string
+	metricName     string
+	unit           string
+	dimensionName  string
+	dimensionValue string
+	statistics     []string
+
+	rows []*CWMetricRow
+	err  error
+	done chan struct{}
+}
+
+// cwMetricDataBatch collects the requests of the resources of a query in a
+// region, to fetch their statistics with as few GetMetricData calls as
+// possible.
+type cwMetricDataBatch struct {
+	svc         *cloudwatch.Client
+	granularity string
+	window      cwMetricWindow
+	requests    []*cwMetricDataRequest
+	queries     int
+	created     time.Time
+	timer       *time.Timer
+	sent        bool
+}
+
+var cwMetricDataBatches = struct {
+	sync.Mutex
+	m map[string]*cwMetricDataBatch
+}{m: map[string]*cwMetricDataBatch{}}
+
+// listCWMetricStatistics streams the statistics of a metric. GetMetricData
+// doesn't return the unit of the data points, so the unit of the metric, if
+// known, is returned for all of them.
+func listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, namespace string, metricName string, unit string, dimensionName string, dimensionValue string) (*cloudwatch.GetMetricStatisticsOutput, error) {
 	// Create Session
 	svc, err := CloudWatchClient(ctx, d)
 	if err != nil {
@@ -141,54 +337,38 @@ func listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, granularit
 		return nil, err
 	}
 
-	endTime := time.Now()
-	startTime := getCWStartDateForGranularity(granularity)
-	period := getCWPeriodForGranularity(granularity)
+	window := getCWMetricWindow(d)
+	statistics := cwMetricRequestedStatistics(d.QueryContext.Columns)
 
-	params := &cloudwatch.GetMetricStatisticsInput{
-		Namespace:  aws.String(namespace),
-		MetricName: aws.String(metricName),
-		StartTime:  aws.Time(startTime),
-		EndTime:    aws.Time(endTime),
-		Period:     aws.Int32(period),
-		Statistics: []types.Statistic{
-			types.StatisticAverage,
-			types.StatisticSampleCount,
-			types.StatisticSum,
-			types.StatisticMinimum,
-			types.StatisticMaximum,
-		},
+	request := &cwMetricDataRequest{
+		ctx:            ctx,
+		d:              d,
+		namespace:      namespace,
+		metricName:     metricName,
+		unit:           unit,
+		dimensionName:  dimensionName,
+		dimensionValue: dimensionValue,
+		statistics:     statistics,
+		done:           make(chan struct{}),
 	}
+	// The query data of the resources of a query share its query context, so
+	// batches are kept per query, to be rate limited and cancelled with it
+	batchKey := fmt.Sprintf("%p/%s/%s/%s/%s/%v", d.QueryContext, d.Connection.Name, d.EqualsQualString(matrixKeyRegion), granularity, window, statistics)
+	addCWMetricDataRequest(svc, batchKey, granularity, window, request)
 
-	if dimensionName != "" && dimensionValue != "" {
-		params.Dimensions = []types.Dimension{
-			{
-				Name:  aws.String(dimensionName),
-				Value: aws.String(dimensionValue),
-			},
-		}
+	select {
+	case <-request.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 
-	stats, err := svc.GetMetricStatistics(ctx, params)
-	if err != nil {
-		plugin.Logger(ctx).Error("listCWMetricStatistics", "api_error", err)
-		return nil, err
+	if request.err != nil {
+		plugin.Logger(ctx).Error("listCWMetricStatistics", "api_error", request.err)
+		return nil, request.err
 	}
 
-	for _, datapoint := range stats.Datapoints {
-		d.StreamLeafListItem(ctx, &CWMetricRow{
-			DimensionValue: aws.String(dimensionValue),
-			DimensionName:  aws.String(dimensionName),
-			Namespace:      aws.String(namespace),
-			MetricName:     aws.String(metricName),
-			Average:        datapoint.Average,
-			Maximum:        datapoint.Maximum,
-			Minimum:        datapoint.Minimum,
-			Timestamp:      datapoint.Timestamp,
-			SampleCount:    datapoint.SampleCount,
-			Sum:            datapoint.Sum,
-			Unit:           aws.String(fmt.Sprint(datapoint.Unit)),
-		})
+	for _, row := range request.rows {
+		d.StreamLeafListItem(ctx, row)
 
 		if d.RowsRemaining(ctx) == 0 {
 			return nil, nil
@@ -197,3 +377,292 @@ func listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, granularit
 
 	return nil, nil
 }
+
+// cwMetricRequestedStatistics returns the statistics for the requested
+// columns. If no statistic is requested, the sample count is used to find the
+// data points.
+func cwMetricRequestedStatistics(columns []string) []string {
+	var statistics []string
+	for _, column := range columns {
+		if statistic, ok := cwMetricStatistics[column]; ok {
+			statistics = append(statistics, string(statistic))
+		}
+	}
+	statistics = append(statistics, cwMetricRequestedExtendedStatistics(columns)...)
+	if len(statistics) == 0 {
+		statistics = []string{string(types.StatisticSampleCount)}
+	}
+	sort.Strings(statistics)
+	return statistics
+}
+
+// cwMetricRequestedExtendedStatistics returns the percentiles for the
+// requested columns. All of them are returned for extended_statistics.
+func cwMetricRequestedExtendedStatistics(columns []string) []string {
+	var extendedStatistics []string
+	for _, column := range columns {
+		if column == "extended_statistics" {
+			return cwMetricExtendedStatistics
+		}
+		for _, statistic := range cwMetricExtendedStatistics {
+			if column == statistic {
+				extendedStatistics = append(extendedStatistics, statistic)
+			}
+		}
+	}
+	return extendedStatistics
+}
+
+// addCWMetricDataRequest adds the request to the pending batch for the key. The
+// batch is sent when it's full, when no request has been added to it for
+// cwMetricDataBatchIdleWait, or cwMetricDataBatchWait after its first request.
+func addCWMetricDataRequest(svc *cloudwatch.Client, batchKey string, granularity string, window cwMetricWindow, request *cwMetricDataRequest) {
+	cwMetricDataBatches.Lock()
+	defer cwMetricDataBatches.Unlock()
+
+	batch := cwMetricDataBatches.m[batchKey]
+	if batch == nil {
+		batch = &cwMetricDataBatch{
+			svc:         svc,
+			granularity: granularity,
+			window:      window,
+			created:     time.Now(),
+		}
+		cwMetricDataBatches.m[batchKey] = batch
+		batch.timer = time.AfterFunc(cwMetricDataBatchIdleWait, func() {
+			cwMetricDataBatches.Lock()
+			send := batch.take(batchKey)
+			cwMetricDataBatches.Unlock()
+			if send {
+				batch.send()
+			}
+		})
+	} else {
+		batch.timer.Reset(min(cwMetricDataBatchIdleWait, time.Until(batch.created.Add(cwMetricDataBatchWait))))
+	}
+
+	batch.requests = append(batch.requests, request)
+	batch.queries += len(request.statistics)
+
+	// Send the batch now if it can't take the statistics of another resource
+	if batch.queries+len(request.statistics) > cwMetricDataMaxQueries && batch.take(batchKey) {
+		batch.timer.Stop()
+		go batch.send()
+	}
+}
+
+// take removes the batch from the pending batches, returning false if it has
+// already been taken to be sent. It must be called with the lock held.
+func (batch *cwMetricDataBatch) take(batchKey string) bool {
+	if batch.sent {
+		return false
+	}
+	batch.sent = true
+	if cwMetricDataBatches.m[batchKey] == batch {
+		delete(cwMetricDataBatches.m, batchKey)
+	}
+	return true
+}
+
+// send gets the statistics of the requests in the batch with GetMetricData,
+// and sets the rows of each request. Requests of queries that are cancelled or
+// already have all their rows are skipped.
+func (batch *cwMetricDataBatch) send() {
+	var requests []*cwMetricDataRequest
+	for _, request := range batch.requests {
+		if err := request.ctx.Err(); err != nil {
+			request.err = err
+			close(request.done)
+			continue
+		}
+		if request.d.RowsRemaining(request.ctx) == 0 {
+			close(request.done)
+			continue
+		}
+		requests = append(requests, request)
+	}
+	if len(requests) == 0 {
+		return
+	}
+
+	// The requests of a batch are all of the same query
+	ctx, d := requests[0].ctx, requests[0].d
+
+	startTime, endTime, period := batch.window.resolve(batch.granularity)
+	queries, queryStatistics := buildCWMetricDataQueries(requests, period)
+	params := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(startTime),
+		EndTime:           aws.Time(endTime),
+		MetricDataQueries: queries,
+		ScanBy:            types.ScanByTimestampAscending,
+	}
+
+	var results []types.MetricDataResult
+	paginator := cloudwatch.NewGetMetricDataPaginator(batch.svc, params)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			for _, request := range requests {
+				request.err = err
+				close(request.done)
+			}
+			return
+		}
+		results = append(results, output.MetricDataResults...)
+	}
+
+	setCWMetricDataRows(requests, queryStatistics, results, period)
+	for _, request := range requests {
+		close(request.done)
+	}
+}
+
+// cwMetricDataQueryStatistic is the request and statistic a metric query is for.
+type cwMetricDataQueryStatistic struct {
+	request   *cwMetricDataRequest
+	statistic string
+}
+
+// buildCWMetricDataQueries returns a metric query per statistic of each
+// request, along with the request and statistic for each query ID.
+func buildCWMetricDataQueries(requests []*cwMetricDataRequest, period int32) ([]types.MetricDataQuery, map[string]cwMetricDataQueryStatistic) {
+	var queries []types.MetricDataQuery
+	queryStatistics := map[string]cwMetricDataQueryStatistic{}
+
+	for _, request := range requests {
+		metric := &types.Metric{
+			Namespace:  aws.String(request.namespace),
+			MetricName: aws.String(request.metricName),
+		}
+		if request.dimensionName != "" && request.dimensionValue != "" {
+			metric.Dimensions = []types.Dimension{
+				{
+					Name:  aws.String(request.dimensionName),
+					Value: aws.String(request.dimensionValue),
+				},
+			}
+		}
+
+		for _, statistic := range request.statistics {
+			// IDs must start with a lowercase letter
+			id := fmt.Sprintf("m%d", len(queries))
+			queries = append(queries, types.MetricDataQuery{
+				Id: aws.String(id),
+				MetricStat: &types.MetricStat{
+					Metric: metric,
+					Period: aws.Int32(period),
+					Stat:   aws.String(statistic),
+				},
+				ReturnData: aws.Bool(true),
+			})
+			queryStatistics[id] = cwMetricDataQueryStatistic{request, statistic}
+		}
+	}
+
+	return queries, queryStatistics
+}
+
+// setCWMetricDataRows merges the values of each statistic of a request into a
+// row per timestamp, as returned by GetMetricStatistics.
+func setCWMetricDataRows(requests []*cwMetricDataRequest, queryStatistics map[string]cwMetricDataQueryStatistic, results []types.MetricDataResult, period int32) {
+	requestRows := map[*cwMetricDataRequest]map[time.Time]*CWMetricRow{}
+
+	for _, result := range results {
+		queryStatistic, ok := queryStatistics[aws.ToString(result.Id)]
+		if !ok {
+			continue
+		}
+		request := queryStatistic.request
+		if requestRows[request] == nil {
+			requestRows[request] = map[time.Time]*CWMetricRow{}
+		}
+
+		for i, timestamp := range result.Timestamps {
+			if i >= len(result.Values) {
+				break
+			}
+			row := requestRows[request][timestamp]
+			if row == nil {
+				row = &CWMetricRow{
+					DimensionValue: aws.String(request.dimensionValue),
+					DimensionName:  aws.String(request.dimensionName),
+					Namespace:      aws.String(request.namespace),
+					MetricName:     aws.String(request.metricName),
+					Period:         aws.Int32(period),
+					Timestamp:      aws.Time(timestamp),
+				}
+				if request.unit != "" {
+					row.Unit = aws.String(request.unit)
+				}
+				requestRows[request][timestamp] = row
+			}
+
+			value := aws.Float64(result.Values[i])
+			switch types.Statistic(queryStatistic.statistic) {
+			case types.StatisticAverage:
+				row.Average = value
+			case types.StatisticMaximum:
+				row.Maximum = value
+			case types.StatisticMinimum:
+				row.Minimum = value
+			case types.StatisticSampleCount:
+				row.SampleCount = value
+			case types.StatisticSum:
+				row.Sum = value
+			default:
+				if row.ExtendedStatistics == nil {
+					row.ExtendedStatistics = map[string]float64{}
+				}
+				row.ExtendedStatistics[queryStatistic.statistic] = *value
+			}
+		}
+	}
+
+	for _, request := range requests {
+		for _, row := range requestRows[request] {
+			request.rows = append(request.rows, row)
+		}
+		sort.Slice(request.rows, func(i, j int) bool { return request.rows[i].Timestamp.Before(*request.rows[j].Timestamp) })
+	}
+}
+
+// getCWMetricStatisticsWithExtended gets the statistics, along with the
+// extended statistics if any are requested. GetMetricStatistics doesn't allow
+// both in a single call, so the extended statistics are merged into the data
+// points of the statistics by timestamp.
+func getCWMetricStatisticsWithExtended(ctx context.Context, svc *cloudwatch.Client, params *cloudwatch.GetMetricStatisticsInput, extendedStatistics []string) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	stats, err := svc.GetMetricStatistics(ctx, params)
+	if err != nil || len(extendedStatistics) == 0 {
+		return stats, err
+	}
+
+	extendedParams := *params
+	extendedParams.Statistics = nil
+	extendedParams.ExtendedStatistics = extendedStatistics
+	extendedStats, err := svc.GetMetricStatistics(ctx, &extendedParams)
+	if err != nil {
+		return nil, err
+	}
+
+	datapoints := map[time.Time]int{}
+	for i, datapoint := range stats.Datapoints {
+		if datapoint.Timestamp != nil {
+			datapoints[*datapoint.Timestamp] = i
+		}
+	}
+	for _, datapoint := range extendedStats.Datapoints {
+		if datapoint.Timestamp == nil {
+			continue
+		}
+		if i, ok := datapoints[*datapoint.Timestamp]; ok {
+			stats.Datapoints[i].ExtendedStatistics = datapoint.ExtendedStatistics
+		} else {
+			stats.Datapoints = append(stats.Datapoints, datapoint)
+		}
+	}
+
+	return stats, nil
+}
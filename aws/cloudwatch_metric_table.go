@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// cwMetricTableSpec declares a table of the statistics of a CloudWatch metric,
+// with the data points of each resource listed by the parent hydrate.
+type cwMetricTableSpec struct {
+	Name        string
+	Description string
+	Namespace   string
+	MetricName  string
+
+	// Unit is the unit the metric is published in, returned for its data
+	// points as GetMetricData doesn't return it.
+	Unit string
+
+	// Granularity is 5_MIN, HOURLY or DAILY, and sets the default time range
+	// and period of the statistics.
+	Granularity string
+
+	// ParentHydrate lists the resources to get the statistics of. Without it,
+	// the statistics of the metric without dimensions are returned.
+	ParentHydrate plugin.HydrateFunc
+
+	// DimensionName is the dimension identifying the resource in the metric.
+	DimensionName string
+
+	// DimensionField is the path of the dimension value in the parent item,
+	// e.g. InstanceId or Attributes.QueueUrl.
+	DimensionField string
+
+	// DimensionValue optionally converts the field into the dimension value,
+	// e.g. to get the name of a resource from its ARN.
+	DimensionValue func(string) string
+
+	// KeyColumn is the column returning the dimension value.
+	KeyColumn            string
+	KeyColumnDescription string
+}
+
+// cwMetricTable returns the table declared by the spec.
+func cwMetricTable(spec cwMetricTableSpec) *plugin.Table {
+	var columns []*plugin.Column
+	if spec.KeyColumn != "" {
+		columns = append(columns, &plugin.Column{
+			Name:        spec.KeyColumn,
+			Description: spec.KeyColumnDescription,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("DimensionValue"),
+		})
+	}
+
+	return &plugin.Table{
+		Name:        spec.Name,
+		Description: spec.Description,
+		List: &plugin.ListConfig{
+			ParentHydrate: spec.ParentHydrate,
+			Hydrate:       spec.listCWMetricStatistics,
+			KeyColumns:    cwMetricKeyColumns(),
+			Tags:          map[string]string{"service": "cloudwatch", "action": "GetMetricData"},
+		},
+		GetMatrixItemFunc: CloudWatchRegionsMatrix,
+		Columns:           awsRegionalColumns(cwMetricColumns(columns)),
+	}
+}
+
+func (spec cwMetricTableSpec) listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if spec.ParentHydrate == nil {
+		return listCWMetricStatistics(ctx, d, spec.Granularity, spec.Namespace, spec.MetricName, spec.Unit, "", "")
+	}
+
+	dimensionValue := spec.dimensionValue(h.Item)
+	if dimensionValue == "" {
+		return nil, nil
+	}
+	return listCWMetricStatistics(ctx, d, spec.Granularity, spec.Namespace, spec.MetricName, spec.Unit, spec.DimensionName, dimensionValue)
+}
+
+// dimensionValue returns the value of the dimension for the parent item, or
+// an empty string if the item doesn't have it.
+func (spec cwMetricTableSpec) dimensionValue(item interface{}) string {
+	field, ok := helpers.GetNestedFieldValueFromInterface(item, spec.DimensionField)
+	if !ok || helpers.IsNil(field) {
+		return ""
+	}
+	value := types.SafeString(field)
+	if spec.DimensionValue != nil && value != "" {
+		value = spec.DimensionValue(value)
+	}
+	return value
+}
+
+// cwMetricArnResource returns the resource of an ARN, without its type, e.g.
+// app/my-load-balancer/50dc6c495c0c9188 for a load balancer.
+func cwMetricArnResource(arn string) string {
+	parts := strings.SplitN(arn, "/", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// cwMetricArnResourceName returns the name of the resource of an ARN, e.g. the
+// name of an ECS cluster.
+func cwMetricArnResourceName(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// cwMetricArnName returns the last part of an ARN, e.g. the name of an SNS
+// topic.
+func cwMetricArnName(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// cwMetricUrlName returns the last part of a URL path, e.g. the name of an
+// SQS queue.
+func cwMetricUrlName(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+func TestCWMetricTableSpecDimensionValue(t *testing.T) {
+	cases := []struct {
+		spec     cwMetricTableSpec
+		item     interface{}
+		expected string
+	}{
+		{
+			cwMetricTableSpec{DimensionField: "InstanceId"},
+			ec2types.Instance{InstanceId: aws.String("i-1234567890abcdef0")},
+			"i-1234567890abcdef0",
+		},
+		{
+			cwMetricTableSpec{DimensionField: "LoadBalancerArn", DimensionValue: cwMetricArnResource},
+			elbv2types.LoadBalancer{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188")},
+			"app/my-lb/50dc6c495c0c9188",
+		},
+		{
+			cwMetricTableSpec{DimensionField: "Attributes.QueueUrl", DimensionValue: cwMetricUrlName},
+			&sqs.GetQueueAttributesOutput{Attributes: map[string]string{"QueueUrl": "https://sqs.us-east-1.amazonaws.com/123456789012/my-queue"}},
+			"my-queue",
+		},
+		{
+			cwMetricTableSpec{DimensionField: "InstanceId"},
+			ec2types.Instance{},
+			"",
+		},
+	}
+	for _, c := range cases {
+		if value := c.spec.dimensionValue(c.item); value != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.spec.DimensionField, c.expected, value)
+		}
+	}
+}
+
+func TestCWMetricTableSpecs(t *testing.T) {
+	names := map[string]bool{}
+	for _, spec := range cwMetricTableSpecs {
+		if names[spec.Name] {
+			t.Errorf("duplicate table %s", spec.Name)
+		}
+		names[spec.Name] = true
+
+		if spec.ParentHydrate != nil && (spec.DimensionName == "" || spec.DimensionField == "" || spec.KeyColumn == "") {
+			t.Errorf("%s: tables with a parent hydrate need a dimension and key column", spec.Name)
+		}
+	}
+}
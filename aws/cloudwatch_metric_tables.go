@@ -0,0 +1,734 @@
+package aws
+
+// cwMetricTableSpecs are the tables of the statistics of a CloudWatch metric per
+// resource, or of an account level metric for the specs without a parent hydrate.
+var cwMetricTableSpecs = []cwMetricTableSpec{
+	{
+		Name:                 "aws_api_gateway_rest_api_metric_5xx_error",
+		Description:          "AWS API Gateway REST API Cloudwatch Metrics - 5XX Error",
+		Namespace:            "AWS/ApiGateway",
+		MetricName:           "5XXError",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRestAPI,
+		DimensionName:        "ApiName",
+		DimensionField:       "Name",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the REST API.",
+	},
+	{
+		Name:                 "aws_api_gateway_rest_api_metric_latency",
+		Description:          "AWS API Gateway REST API Cloudwatch Metrics - Latency",
+		Namespace:            "AWS/ApiGateway",
+		MetricName:           "Latency",
+		Unit:                 "Milliseconds",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRestAPI,
+		DimensionName:        "ApiName",
+		DimensionField:       "Name",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the REST API.",
+	},
+	{
+		Name:        "aws_dynamodb_metric_account_provisioned_read_capacity_util",
+		Description: "AWS DynamoDB Metric Account Provisioned Read Capacity Utilization",
+		Namespace:   "AWS/DynamoDB",
+		MetricName:  "AccountProvisionedReadCapacityUtilization",
+		Unit:        "Percent",
+		Granularity: "5_MIN",
+	},
+	{
+		Name:        "aws_dynamodb_metric_account_provisioned_write_capacity_util",
+		Description: "AWS DynamoDB Metric Account Provisioned Write Capacity Utilization",
+		Namespace:   "AWS/DynamoDB",
+		MetricName:  "AccountProvisionedWriteCapacityUtilization",
+		Unit:        "Percent",
+		Granularity: "5_MIN",
+	},
+	{
+		Name:                 "aws_dynamodb_table_metric_consumed_read_capacity_units",
+		Description:          "AWS DynamoDB Table Cloudwatch Metrics - Consumed Read Capacity Units",
+		Namespace:            "AWS/DynamoDB",
+		MetricName:           "ConsumedReadCapacityUnits",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listDynamoDBTables,
+		DimensionName:        "TableName",
+		DimensionField:       "TableName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the table.",
+	},
+	{
+		Name:                 "aws_dynamodb_table_metric_consumed_write_capacity_units",
+		Description:          "AWS DynamoDB Table Cloudwatch Metrics - Consumed Write Capacity Units",
+		Namespace:            "AWS/DynamoDB",
+		MetricName:           "ConsumedWriteCapacityUnits",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listDynamoDBTables,
+		DimensionName:        "TableName",
+		DimensionField:       "TableName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the table.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_burst_balance",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Burst Balance",
+		Namespace:            "AWS/EBS",
+		MetricName:           "BurstBalance",
+		Unit:                 "Percent",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_read_ops",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Read Ops",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeReadOps",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_read_ops_daily",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Read Ops (Daily)",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeReadOps",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_read_ops_hourly",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Read Ops (Hourly)",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeReadOps",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_write_ops",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Write Ops",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeWriteOps",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_write_ops_daily",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Write Ops (Daily)",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeWriteOps",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ebs_volume_metric_write_ops_hourly",
+		Description:          "AWS EBS Volume Cloudwatch Metrics - Write Ops (Hourly)",
+		Namespace:            "AWS/EBS",
+		MetricName:           "VolumeWriteOps",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listEBSVolume,
+		DimensionName:        "VolumeId",
+		DimensionField:       "VolumeId",
+		KeyColumn:            "volume_id",
+		KeyColumnDescription: "The EBS Volume ID.",
+	},
+	{
+		Name:                 "aws_ec2_application_load_balancer_metric_request_count",
+		Description:          "AWS EC2 Application Load Balancer Metrics - Request Count",
+		Namespace:            "AWS/ApplicationELB",
+		MetricName:           "RequestCount",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEc2ApplicationLoadBalancers,
+		DimensionName:        "LoadBalancer",
+		DimensionField:       "LoadBalancerArn",
+		DimensionValue:       cwMetricArnResource,
+		KeyColumn:            "name",
+		KeyColumnDescription: "The friendly name of the Load Balancer that was provided during resource creation.",
+	},
+	{
+		Name:                 "aws_ec2_application_load_balancer_metric_request_count_daily",
+		Description:          "AWS EC2 Application Load Balancer Metrics - Request Count (Daily)",
+		Namespace:            "AWS/ApplicationELB",
+		MetricName:           "RequestCount",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEc2ApplicationLoadBalancers,
+		DimensionName:        "LoadBalancer",
+		DimensionField:       "LoadBalancerArn",
+		DimensionValue:       cwMetricArnResource,
+		KeyColumn:            "name",
+		KeyColumnDescription: "The friendly name of the Load Balancer that was provided during resource creation.",
+	},
+	{
+		Name:                 "aws_ec2_application_load_balancer_metric_target_response_time",
+		Description:          "AWS EC2 Application Load Balancer Metrics - Target Response Time",
+		Namespace:            "AWS/ApplicationELB",
+		MetricName:           "TargetResponseTime",
+		Unit:                 "Seconds",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEc2ApplicationLoadBalancers,
+		DimensionName:        "LoadBalancer",
+		DimensionField:       "LoadBalancerArn",
+		DimensionValue:       cwMetricArnResource,
+		KeyColumn:            "name",
+		KeyColumnDescription: "The friendly name of the Load Balancer that was provided during resource creation.",
+	},
+	{
+		Name:                 "aws_ec2_instance_metric_cpu_utilization",
+		Description:          "AWS EC2 Instance Cloudwatch Metrics - CPU Utilization",
+		Namespace:            "AWS/EC2",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEc2Instance,
+		DimensionName:        "InstanceId",
+		DimensionField:       "InstanceId",
+		KeyColumn:            "instance_id",
+		KeyColumnDescription: "The ID of the instance.",
+	},
+	{
+		Name:                 "aws_ec2_instance_metric_cpu_utilization_daily",
+		Description:          "AWS EC2 Instance Cloudwatch Metrics - CPU Utilization (Daily)",
+		Namespace:            "AWS/EC2",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEc2Instance,
+		DimensionName:        "InstanceId",
+		DimensionField:       "InstanceId",
+		KeyColumn:            "instance_id",
+		KeyColumnDescription: "The ID of the instance.",
+	},
+	{
+		Name:                 "aws_ec2_instance_metric_cpu_utilization_hourly",
+		Description:          "AWS EC2 Instance Cloudwatch Metrics - CPU Utilization (Hourly)",
+		Namespace:            "AWS/EC2",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listEc2Instance,
+		DimensionName:        "InstanceId",
+		DimensionField:       "InstanceId",
+		KeyColumn:            "instance_id",
+		KeyColumnDescription: "The ID of the instance.",
+	},
+	{
+		Name:                 "aws_ec2_network_load_balancer_metric_net_flow_count",
+		Description:          "AWS EC2 Network Load Balancer Metrics - Net Flow Count",
+		Namespace:            "AWS/NetworkELB",
+		MetricName:           "NewFlowCount",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEc2NetworkLoadBalancers,
+		DimensionName:        "LoadBalancer",
+		DimensionField:       "LoadBalancerArn",
+		DimensionValue:       cwMetricArnResource,
+		KeyColumn:            "name",
+		KeyColumnDescription: "The friendly name of the Load Balancer.",
+	},
+	{
+		Name:                 "aws_ec2_network_load_balancer_metric_net_flow_count_daily",
+		Description:          "AWS EC2 Network Load Balancer Metrics - Net Flow Count (Daily)",
+		Namespace:            "AWS/NetworkELB",
+		MetricName:           "NewFlowCount",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEc2NetworkLoadBalancers,
+		DimensionName:        "LoadBalancer",
+		DimensionField:       "LoadBalancerArn",
+		DimensionValue:       cwMetricArnResource,
+		KeyColumn:            "name",
+		KeyColumnDescription: "The friendly name of the Load Balancer.",
+	},
+	{
+		Name:                 "aws_ecs_cluster_metric_cpu_utilization",
+		Description:          "AWS ECS Cluster Cloudwatch Metrics - CPU Utilization",
+		Namespace:            "AWS/ECS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEcsClusters,
+		DimensionName:        "ClusterName",
+		DimensionField:       "ClusterArn",
+		DimensionValue:       cwMetricArnResourceName,
+		KeyColumn:            "cluster_name",
+		KeyColumnDescription: "A user-generated string that you use to identify your cluster.",
+	},
+	{
+		Name:                 "aws_ecs_cluster_metric_cpu_utilization_daily",
+		Description:          "AWS ECS Cluster Cloudwatch Metrics - CPU Utilization (Daily)",
+		Namespace:            "AWS/ECS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "DAILY",
+		ParentHydrate:        listEcsClusters,
+		DimensionName:        "ClusterName",
+		DimensionField:       "ClusterArn",
+		DimensionValue:       cwMetricArnResourceName,
+		KeyColumn:            "cluster_name",
+		KeyColumnDescription: "A user-generated string that you use to identify your cluster.",
+	},
+	{
+		Name:                 "aws_ecs_cluster_metric_cpu_utilization_hourly",
+		Description:          "AWS ECS Cluster Cloudwatch Metrics - CPU Utilization (Hourly)",
+		Namespace:            "AWS/ECS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listEcsClusters,
+		DimensionName:        "ClusterName",
+		DimensionField:       "ClusterArn",
+		DimensionValue:       cwMetricArnResourceName,
+		KeyColumn:            "cluster_name",
+		KeyColumnDescription: "A user-generated string that you use to identify your cluster.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_cache_hits_hourly",
+		Description:          "AWS Elasticache Redis CacheHits metric (Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "CacheHits",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_curr_connections_hourly",
+		Description:          "AWS Elasticache Redis CurrConnections metric (Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "CurrConnections",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_engine_cpu_utilization_daily",
+		Description:          "AWS Elasticache Redis EngineCPUUtilization metric (Daily)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "EngineCPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "DAILY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_engine_cpu_utilization_hourly",
+		Description:          "AWS Elasticache Redis EngineCPUUtilization metric (Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "EngineCPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_get_type_cmds_hourly",
+		Description:          "AWS Elasticache Redis GetTypeCmds metric(Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "GetTypeCmds",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_list_based_cmds_hourly",
+		Description:          "AWS Elasticache Redis ListBasedCmds metric (Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "ListBasedCmds",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_elasticache_redis_metric_new_connections_hourly",
+		Description:          "AWS Elasticache Redis NewConnections metric (Hourly)",
+		Namespace:            "AWS/ElastiCache",
+		MetricName:           "NewConnections",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listElastiCacheClusters,
+		DimensionName:        "CacheClusterId",
+		DimensionField:       "CacheClusterId",
+		KeyColumn:            "cache_cluster_id",
+		KeyColumnDescription: "The cache cluster id.",
+	},
+	{
+		Name:                 "aws_emr_cluster_metric_is_idle",
+		Description:          "AWS EMR Cluster Cloudwatch Metrics - IsIdle",
+		Namespace:            "AWS/ElasticMapReduce",
+		MetricName:           "IsIdle",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listEmrClusters,
+		DimensionName:        "JobFlowId",
+		DimensionField:       "Id",
+		KeyColumn:            "id",
+		KeyColumnDescription: "The unique identifier for the cluster.",
+	},
+	{
+		Name:                 "aws_kinesis_stream_metric_get_records_iterator_age",
+		Description:          "AWS Kinesis Stream Cloudwatch Metrics - GetRecords Iterator Age",
+		Namespace:            "AWS/Kinesis",
+		MetricName:           "GetRecords.IteratorAgeMilliseconds",
+		Unit:                 "Milliseconds",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listStreams,
+		DimensionName:        "StreamName",
+		DimensionField:       "StreamDescription.StreamName",
+		KeyColumn:            "stream_name",
+		KeyColumnDescription: "The name of the stream.",
+	},
+	{
+		Name:                 "aws_lambda_function_metric_concurrent_executions",
+		Description:          "AWS Lambda Function Cloudwatch Metrics - Concurrent Executions",
+		Namespace:            "AWS/Lambda",
+		MetricName:           "ConcurrentExecutions",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listAwsLambdaFunctions,
+		DimensionName:        "FunctionName",
+		DimensionField:       "FunctionName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the function.",
+	},
+	{
+		Name:                 "aws_lambda_function_metric_duration_daily",
+		Description:          "AWS Lambda Function Cloudwatch Metrics - Duration (Daily)",
+		Namespace:            "AWS/Lambda",
+		MetricName:           "Duration",
+		Unit:                 "Milliseconds",
+		Granularity:          "DAILY",
+		ParentHydrate:        listAwsLambdaFunctions,
+		DimensionName:        "FunctionName",
+		DimensionField:       "FunctionName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the function.",
+	},
+	{
+		Name:                 "aws_lambda_function_metric_errors_daily",
+		Description:          "AWS Lambda Function Cloudwatch Metrics - Errors (Daily)",
+		Namespace:            "AWS/Lambda",
+		MetricName:           "Errors",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listAwsLambdaFunctions,
+		DimensionName:        "FunctionName",
+		DimensionField:       "FunctionName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the function.",
+	},
+	{
+		Name:                 "aws_lambda_function_metric_invocations_daily",
+		Description:          "AWS Lambda Function Cloudwatch Metrics - Invocations (Daily)",
+		Namespace:            "AWS/Lambda",
+		MetricName:           "Invocations",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listAwsLambdaFunctions,
+		DimensionName:        "FunctionName",
+		DimensionField:       "FunctionName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the function.",
+	},
+	{
+		Name:                 "aws_lambda_function_metric_throttles",
+		Description:          "AWS Lambda Function Cloudwatch Metrics - Throttles",
+		Namespace:            "AWS/Lambda",
+		MetricName:           "Throttles",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listAwsLambdaFunctions,
+		DimensionName:        "FunctionName",
+		DimensionField:       "FunctionName",
+		KeyColumn:            "name",
+		KeyColumnDescription: "The name of the function.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_connections",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - DB Connections",
+		Namespace:            "AWS/RDS",
+		MetricName:           "DatabaseConnections",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_connections_daily",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - DB Connections (Daily)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "DatabaseConnections",
+		Unit:                 "Count",
+		Granularity:          "DAILY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_connections_hourly",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - DB Connections (Hourly)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "DatabaseConnections",
+		Unit:                 "Count",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_cpu_utilization",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - CPU Utilization",
+		Namespace:            "AWS/RDS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_cpu_utilization_daily",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - CPU Utilization (Daily)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "DAILY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_cpu_utilization_hourly",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - CPU Utilization (Hourly)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_read_iops",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Read IOPS",
+		Namespace:            "AWS/RDS",
+		MetricName:           "ReadIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_read_iops_daily",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Read IOPS (Daily)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "ReadIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "DAILY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_read_iops_hourly",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Read IOPS (Hourly)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "ReadIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_write_iops",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Write IOPS",
+		Namespace:            "AWS/RDS",
+		MetricName:           "WriteIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_write_iops_daily",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Write IOPS (Daily)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "WriteIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "DAILY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_rds_db_instance_metric_write_iops_hourly",
+		Description:          "AWS RDS DB Instance Cloudwatch Metrics - Write IOPS (Hourly)",
+		Namespace:            "AWS/RDS",
+		MetricName:           "WriteIOPS",
+		Unit:                 "Count/Second",
+		Granularity:          "HOURLY",
+		ParentHydrate:        listRDSDBInstances,
+		DimensionName:        "DBInstanceIdentifier",
+		DimensionField:       "DBInstanceIdentifier",
+		KeyColumn:            "db_instance_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_redshift_cluster_metric_cpu_utilization_daily",
+		Description:          "AWS Redshift Cluster Cloudwatch Metrics - CPU Utilization (Daily)",
+		Namespace:            "AWS/Redshift",
+		MetricName:           "CPUUtilization",
+		Unit:                 "Percent",
+		Granularity:          "DAILY",
+		ParentHydrate:        listRedshiftClusters,
+		DimensionName:        "ClusterIdentifier",
+		DimensionField:       "ClusterIdentifier",
+		KeyColumn:            "cluster_identifier",
+		KeyColumnDescription: "The friendly name to identify the DB Instance.",
+	},
+	{
+		Name:                 "aws_sns_topic_metric_number_of_notifications_failed",
+		Description:          "AWS SNS Topic Cloudwatch Metrics - Number Of Notifications Failed",
+		Namespace:            "AWS/SNS",
+		MetricName:           "NumberOfNotificationsFailed",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listAwsSnsTopics,
+		DimensionName:        "TopicName",
+		DimensionField:       "Attributes.TopicArn",
+		DimensionValue:       cwMetricArnName,
+		KeyColumn:            "topic_name",
+		KeyColumnDescription: "The name of the topic.",
+	},
+	{
+		Name:                 "aws_sqs_queue_metric_approximate_age_of_oldest_message",
+		Description:          "AWS SQS Queue Cloudwatch Metrics - Approximate Age Of Oldest Message",
+		Namespace:            "AWS/SQS",
+		MetricName:           "ApproximateAgeOfOldestMessage",
+		Unit:                 "Seconds",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listAwsSqsQueues,
+		DimensionName:        "QueueName",
+		DimensionField:       "Attributes.QueueUrl",
+		DimensionValue:       cwMetricUrlName,
+		KeyColumn:            "queue_name",
+		KeyColumnDescription: "The name of the queue.",
+	},
+	{
+		Name:                 "aws_sqs_queue_metric_number_of_messages_received",
+		Description:          "AWS SQS Queue Cloudwatch Metrics - Number Of Messages Received",
+		Namespace:            "AWS/SQS",
+		MetricName:           "NumberOfMessagesReceived",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listAwsSqsQueues,
+		DimensionName:        "QueueName",
+		DimensionField:       "Attributes.QueueUrl",
+		DimensionValue:       cwMetricUrlName,
+		KeyColumn:            "queue_name",
+		KeyColumnDescription: "The name of the queue.",
+	},
+	{
+		Name:                 "aws_vpc_nat_gateway_metric_bytes_out_to_destination",
+		Description:          "AWS VPC Nat Gateway Cloudwatch Metrics - BytesOutToDestination",
+		Namespace:            "AWS/NATGateway",
+		MetricName:           "BytesOutToDestination",
+		Unit:                 "Bytes",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listVpcNatGateways,
+		DimensionName:        "NatGatewayId",
+		DimensionField:       "NatGatewayId",
+		KeyColumn:            "nat_gateway_id",
+		KeyColumnDescription: "The ID of the NAT gateway.",
+	},
+	{
+		Name:                 "aws_vpc_nat_gateway_metric_error_port_allocation",
+		Description:          "AWS VPC Nat Gateway Cloudwatch Metrics - ErrorPortAllocation",
+		Namespace:            "AWS/NATGateway",
+		MetricName:           "ErrorPortAllocation",
+		Unit:                 "Count",
+		Granularity:          "5_MIN",
+		ParentHydrate:        listVpcNatGateways,
+		DimensionName:        "NatGatewayId",
+		DimensionField:       "NatGatewayId",
+		KeyColumn:            "nat_gateway_id",
+		KeyColumnDescription: "The ID of the NAT gateway.",
+	},
+}
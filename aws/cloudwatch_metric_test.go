@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestCWMetricRequestedStatistics(t *testing.T) {
+	statistics := cwMetricRequestedStatistics([]string{"instance_id", "timestamp", "sum", "average", "p99", "unit"})
+	if len(statistics) != 3 || statistics[0] != "Average" || statistics[1] != "Sum" || statistics[2] != "p99" {
+		t.Errorf("unexpected statistics %v", statistics)
+	}
+
+	statistics = cwMetricRequestedStatistics([]string{"instance_id", "timestamp"})
+	if len(statistics) != 1 || statistics[0] != "SampleCount" {
+		t.Errorf("expected the sample count when no statistic is requested, got %v", statistics)
+	}
+
+	if extendedStatistics := cwMetricRequestedExtendedStatistics([]string{"extended_statistics", "p99"}); len(extendedStatistics) != len(cwMetricExtendedStatistics) {
+		t.Errorf("expected all the percentiles for extended_statistics, got %v", extendedStatistics)
+	}
+}
+
+func TestCWMetricWindowResolve(t *testing.T) {
+	startTime := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
+	window := cwMetricWindow{startTime: aws.Time(startTime), period: 60}
+	start, end, period := window.resolve("DAILY")
+	if !start.Equal(startTime) || period != 60 || end.Before(startTime) {
+		t.Errorf("unexpected window %v to %v with period %d", start, end, period)
+	}
+
+	start, _, period = cwMetricWindow{}.resolve("HOURLY")
+	if period != 3600 || time.Since(start) < 59*24*time.Hour {
+		t.Errorf("expected the hourly defaults, got %v with period %d", start, period)
+	}
+}
+
+func TestSetCWMetricDataRows(t *testing.T) {
+	statistics := []string{"Average", "Maximum", "p99"}
+	requests := []*cwMetricDataRequest{
+		{namespace: "AWS/EC2", metricName: "CPUUtilization", unit: "Percent", dimensionName: "InstanceId", dimensionValue: "i-1", statistics: statistics},
+		{namespace: "AWS/EC2", metricName: "CPUUtilization", unit: "Percent", dimensionName: "InstanceId", dimensionValue: "i-2", statistics: statistics},
+	}
+
+	queries, queryStatistics := buildCWMetricDataQueries(requests, 300)
+	if len(queries) != 6 {
+		t.Fatalf("expected a query per statistic of each request, got %d", len(queries))
+	}
+	if *queries[4].MetricStat.Metric.Dimensions[0].Value != "i-2" || *queries[4].MetricStat.Stat != "Maximum" {
+		t.Errorf("unexpected query %s for %s", *queries[4].MetricStat.Stat, *queries[4].MetricStat.Metric.Dimensions[0].Value)
+	}
+
+	first := time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)
+	second := first.Add(5 * time.Minute)
+	setCWMetricDataRows(requests, queryStatistics, []types.MetricDataResult{
+		{Id: queries[0].Id, Timestamps: []time.Time{second, first}, Values: []float64{20, 10}},
+		{Id: queries[1].Id, Timestamps: []time.Time{first, second}, Values: []float64{15, 25}},
+		{Id: queries[2].Id, Timestamps: []time.Time{first}, Values: []float64{30}},
+		{Id: queries[3].Id, Timestamps: []time.Time{}, Values: []float64{}},
+	}, 300)
+
+	rows := requests[0].rows
+	if len(rows) != 2 || len(requests[1].rows) != 0 {
+		t.Fatalf("unexpected rows %d and %d", len(rows), len(requests[1].rows))
+	}
+	if !rows[0].Timestamp.Equal(first) || *rows[0].Average != 10 || *rows[0].Maximum != 15 || rows[0].Sum != nil || rows[0].ExtendedStatistics["p99"] != 30 || *rows[0].Period != 300 {
+		t.Errorf("unexpected first row %+v", rows[0])
+	}
+	if !rows[1].Timestamp.Equal(second) || *rows[1].Average != 20 || *rows[1].Maximum != 25 || aws.ToString(rows[1].DimensionValue) != "i-1" || aws.ToString(rows[1].Unit) != "Percent" {
+		t.Errorf("unexpected second row %+v", rows[1])
+	}
+}
+
+func TestCWMetricDataBatchSkipsDoneRequests(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request := &cwMetricDataRequest{ctx: ctx, statistics: []string{"Sum"}, done: make(chan struct{})}
+	// The batch has no client, so sending it must not call GetMetricData
+	batch := &cwMetricDataBatch{requests: []*cwMetricDataRequest{request}}
+	batch.send()
+
+	select {
+	case <-request.done:
+	default:
+		t.Fatal("expected the request of a cancelled query to be done")
+	}
+	if request.err != context.Canceled {
+		t.Errorf("expected the query context error, got %v", request.err)
+	}
+}
+
+func TestCWMetricDataBatchSendsWhenIdle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request := &cwMetricDataRequest{ctx: ctx, statistics: []string{"Sum"}, done: make(chan struct{})}
+	start := time.Now()
+	addCWMetricDataRequest(nil, "TestCWMetricDataBatchSendsWhenIdle", "5_MIN", cwMetricWindow{}, request)
+
+	select {
+	case <-request.done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the batch to be sent")
+	}
+	if elapsed := time.Since(start); elapsed >= cwMetricDataBatchWait {
+		t.Errorf("expected the idle batch to be sent before %v, took %v", cwMetricDataBatchWait, elapsed)
+	}
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Compute Optimizer returns an OptInRequiredException in accounts that are
+// not enrolled, which is the common case, so it is treated as no data.
+var computeOptimizerIgnoreConfig = &plugin.IgnoreConfig{
+	ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OptInRequiredException"}),
+}
+
+// computeOptimizerColumns appends the columns shared by all the Compute
+// Optimizer recommendation tables. Each row embeds the recommendation and
+// holds its top ranked recommendation option in the Option field.
+func computeOptimizerColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, []*plugin.Column{
+		{
+			Name:        "finding",
+			Description: "The finding classification of the resource, such as Overprovisioned, Underprovisioned or Optimized.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "current_performance_risk",
+			Description: "The risk of the current configuration not meeting the performance needs of its workloads. Possible values are: VeryLow, Low, Medium, High.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "estimated_monthly_savings_amount",
+			Description: "The estimated monthly savings possible by adopting the top ranked recommendation option.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Option.SavingsOpportunity.EstimatedMonthlySavings.Value"),
+		},
+		{
+			Name:        "estimated_monthly_savings_currency",
+			Description: "The currency of the estimated monthly savings.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Option.SavingsOpportunity.EstimatedMonthlySavings.Currency"),
+		},
+		{
+			Name:        "savings_opportunity_percentage",
+			Description: "The estimated monthly savings possible by adopting the top ranked recommendation option, as a percentage of the current cost.",
+			Type:        proto.ColumnType_DOUBLE,
+			Transform:   transform.FromField("Option.SavingsOpportunity.SavingsOpportunityPercentage"),
+		},
+		{
+			Name:        "last_refresh_timestamp",
+			Description: "The timestamp of when the recommendation was last generated.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+	}...)
+}
+
+// computeOptimizerArnsInput returns the ARN quals of the column as the
+// resource ARNs to get recommendations for.
+func computeOptimizerArnsInput(d *plugin.QueryData, column string) []string {
+	if arn := d.EqualsQualString(column); arn != "" {
+		return []string{arn}
+	}
+	return nil
+}
+
+// computeOptimizerFinding returns the finding qual as the values of the
+// filter named Finding, which all the recommendation APIs accept.
+func computeOptimizerFinding(d *plugin.QueryData) []string {
+	if finding := d.EqualsQualString("finding"); finding != "" {
+		return []string{finding}
+	}
+	return nil
+}
+
+// computeOptimizerLimit returns the page size of the list calls, reduced if
+// the user has only requested a small number of rows.
+func computeOptimizerLimit(d *plugin.QueryData) *int32 {
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+	return aws.Int32(maxLimit)
+}
@@ -8,17 +8,20 @@ import (
 )
 
 type awsConfig struct {
-	Regions               []string `hcl:"regions,optional"`
-	DefaultRegion         *string  `hcl:"default_region"`
-	Profile               *string  `hcl:"profile"`
-	AccessKey             *string  `hcl:"access_key"`
-	SecretKey             *string  `hcl:"secret_key"`
-	SessionToken          *string  `hcl:"session_token"`
-	MaxErrorRetryAttempts *int     `hcl:"max_error_retry_attempts"`
-	MinErrorRetryDelay    *int     `hcl:"min_error_retry_delay"`
-	IgnoreErrorCodes      []string `hcl:"ignore_error_codes,optional"`
-	EndpointUrl           *string  `hcl:"endpoint_url"`
-	S3ForcePathStyle      *bool    `hcl:"s3_force_path_style"`
+	Regions                   []string `hcl:"regions,optional"`
+	DefaultRegion             *string  `hcl:"default_region"`
+	Profile                   *string  `hcl:"profile"`
+	AccessKey                 *string  `hcl:"access_key"`
+	SecretKey                 *string  `hcl:"secret_key"`
+	SessionToken              *string  `hcl:"session_token"`
+	MaxErrorRetryAttempts     *int     `hcl:"max_error_retry_attempts"`
+	MinErrorRetryDelay        *int     `hcl:"min_error_retry_delay"`
+	IgnoreErrorCodes          []string `hcl:"ignore_error_codes,optional"`
+	EndpointUrl               *string  `hcl:"endpoint_url"`
+	S3ForcePathStyle          *bool    `hcl:"s3_force_path_style"`
+	S3ObjectListParallelism   *int     `hcl:"s3_object_list_parallelism"`
+	PricingOfferFilePath      *string  `hcl:"pricing_offer_file_path"`
+	PricingOfferFileCachePath *string  `hcl:"pricing_offer_file_cache_path"`
 }
 
 func ConfigInstance() interface{} {
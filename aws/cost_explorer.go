@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 // AllCostMetrics is a constant returning all the cost metrics
@@ -120,6 +123,20 @@ var costExplorerColumnDefs = []*plugin.Column{
 		Description: "Unit type for normalized usage.",
 		Type:        proto.ColumnType_STRING,
 	},
+
+	// Quals columns - to filter the lookups
+	{
+		Name:        "filter",
+		Description: "The Cost Explorer filter expression used to query the costs, e.g. {\"Dimensions\": {\"Key\": \"REGION\", \"Values\": [\"us-east-1\"]}}. And, Or and Not expressions over dimensions, tags and cost categories are supported.",
+		Type:        proto.ColumnType_JSON,
+		Transform:   transform.FromQual("filter"),
+	},
+	{
+		Name:        "metrics",
+		Description: "The cost metrics queried, e.g. [\"UnblendedCost\", \"UsageQuantity\"]. If not set, only the metrics of the requested columns are queried.",
+		Type:        proto.ColumnType_JSON,
+		Transform:   transform.FromQual("metrics"),
+	},
 }
 
 // append the common aws cost explorer columns onto the column list
@@ -127,6 +144,83 @@ func costExplorerColumns(columns []*plugin.Column) []*plugin.Column {
 	return append(columns, costExplorerColumnDefs...)
 }
 
+// append the common aws cost explorer key columns onto the key column list
+func costExplorerKeyColumns(keyColumns plugin.KeyColumnSlice) plugin.KeyColumnSlice {
+	return append(keyColumns, plugin.KeyColumnSlice{
+		{Name: "period_start", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+		{Name: "period_end", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+		{Name: "metrics", Require: plugin.Optional, CacheMatch: "exact"},
+	}...)
+}
+
+// isCostExplorerKeyColumn returns true for the common cost explorer key
+// columns, which are not dimensions and must not be turned into dimension filters
+func isCostExplorerKeyColumn(name string) bool {
+	switch name {
+	case "period_start", "period_end", "filter", "metrics":
+		return true
+	}
+	return false
+}
+
+// costExplorerPeriodColumns appends the time period and granularity columns of
+// the tables built on the Cost Explorer commitment APIs, whose results have a
+// TimePeriod.
+func costExplorerPeriodColumns(columns []*plugin.Column) []*plugin.Column {
+	return append([]*plugin.Column{
+		{
+			Name:        "period_start",
+			Description: "Start timestamp for this metric.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("TimePeriod.Start"),
+		},
+		{
+			Name:        "period_end",
+			Description: "End timestamp for this metric.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("TimePeriod.End"),
+		},
+		{
+			Name:        "granularity",
+			Description: "The granularity of the metric data. Possible values are: DAILY|MONTHLY.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("granularity"),
+		},
+	}, columns...)
+}
+
+// costExplorerPeriodKeyColumns returns the granularity and time period key
+// columns of the tables built on the Cost Explorer commitment APIs.
+func costExplorerPeriodKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: "granularity", Require: plugin.Required},
+		{Name: "period_start", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+		{Name: "period_end", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+	}
+}
+
+// ceQualOrDefault returns the value of the equals qual of the column, or the
+// default value if it isn't set, for the required parameters of the purchase
+// recommendation APIs.
+func ceQualOrDefault(d *plugin.QueryData, column string, defaultValue string) string {
+	if value := d.EqualsQualString(column); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// ceMetricColumns maps each cost metric to the columns it populates
+var ceMetricColumns = map[string][]string{
+	"BlendedCost":           {"blended_cost_amount", "blended_cost_unit"},
+	"UnblendedCost":         {"unblended_cost_amount", "unblended_cost_unit"},
+	"NetUnblendedCost":      {"net_unblended_cost_amount", "net_unblended_cost_unit"},
+	"AmortizedCost":         {"amortized_cost_amount", "amortized_cost_unit"},
+	"NetAmortizedCost":      {"net_amortized_cost_amount", "net_amortized_cost_unit"},
+	"UsageQuantity":         {"usage_quantity_amount", "usage_quantity_unit"},
+	"NormalizedUsageAmount": {"normalized_usage_amount", "normalized_usage_unit"},
+}
+
 //// LIST FUNCTION
 
 func streamCostAndUsage(ctx context.Context, d *plugin.QueryData, params *costexplorer.GetCostAndUsageInput) (interface{}, error) {
@@ -137,6 +231,15 @@ func streamCostAndUsage(ctx context.Context, d *plugin.QueryData, params *costex
 		plugin.Logger(ctx).Error("streamCostAndUsage", "client_error", err)
 		return nil, err
 	}
+
+	// Apply the time period, filter and metrics quals
+	if err := setCEQuals(d, params); err != nil {
+		plugin.Logger(ctx).Error("streamCostAndUsage", "qual_error", err)
+		return nil, err
+	}
+	if *params.TimePeriod.Start >= *params.TimePeriod.End {
+		return nil, nil
+	}
 	// List call
 	for {
 		output, err := svc.GetCostAndUsage(ctx, params)
@@ -254,16 +357,180 @@ func (row *CEMetricRow) setRowMetrics(metrics map[string]types.MetricValue) {
 
 }
 
-func getCEStartDateForGranularity(granularity string) time.Time {
+func getCEStartDateForGranularity(granularity string, end time.Time) time.Time {
 	switch granularity {
 	case "DAILY", "MONTHLY":
 		// 1 year
-		return time.Now().AddDate(-1, 0, 0)
+		return end.AddDate(-1, 0, 0)
 	case "HOURLY":
 		// 13 days
-		return time.Now().AddDate(0, 0, -13)
+		return end.AddDate(0, 0, -13)
+	}
+	return end.AddDate(0, 0, -13)
+}
+
+// setCEQuals sets the time period, metrics and filter of the request from the
+// common cost explorer quals. The filter qual is combined with any filter
+// already built from the table's dimension quals.
+func setCEQuals(d *plugin.QueryData, params *costexplorer.GetCostAndUsageInput) error {
+	params.TimePeriod = getCEDateInterval(d.Quals, string(params.Granularity))
+
+	metrics, err := getCEMetrics(d)
+	if err != nil {
+		return err
+	}
+	params.Metrics = metrics
+
+	filter, err := getCEFilterQual(d)
+	if err != nil {
+		return err
+	}
+	if filter != nil {
+		if params.Filter == nil {
+			params.Filter = filter
+		} else if params.Filter.And != nil {
+			params.Filter.And = append(params.Filter.And, *filter)
+		} else {
+			params.Filter = &types.Expression{
+				And: []types.Expression{*params.Filter, *filter},
+			}
+		}
+	}
+
+	return nil
+}
+
+// getCEFilterQual returns the Cost Explorer filter expression of the filter
+// qual, or nil if it isn't set.
+func getCEFilterQual(d *plugin.QueryData) (*types.Expression, error) {
+	if d.EqualsQuals["filter"] == nil {
+		return nil, nil
+	}
+	var filter types.Expression
+	if err := json.Unmarshal([]byte(d.EqualsQuals["filter"].GetJsonbValue()), &filter); err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %v", err)
 	}
-	return time.Now().AddDate(0, 0, -13)
+	return &filter, nil
+}
+
+// getCEDateInterval returns the time period to query, narrowed by the
+// period_start and period_end quals. Without quals, the period defaults to
+// the trailing window returned by getCEStartDateForGranularity, ending now or
+// at the end set by the quals.
+func getCEDateInterval(quals plugin.KeyColumnQualMap, granularity string) *types.DateInterval {
+	timeFormat := "2006-01-02"
+	if granularity == "HOURLY" {
+		timeFormat = "2006-01-02T15:04:05Z"
+	}
+
+	start, end := getCEQualTimeBounds(quals, granularity)
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		start = getCEStartDateForGranularity(granularity, end)
+	}
+
+	return &types.DateInterval{
+		Start: aws.String(start.UTC().Format(timeFormat)),
+		End:   aws.String(end.UTC().Format(timeFormat)),
+	}
+}
+
+// getCEQualTimeBounds returns the start and end of the time period set by the
+// period_start and period_end quals. Either is zero if not set by the quals.
+func getCEQualTimeBounds(quals plugin.KeyColumnQualMap, granularity string) (time.Time, time.Time) {
+	// Length of a single period, used to widen the bounds of the exclusive operators
+	addPeriod := func(t time.Time, n int) time.Time {
+		switch granularity {
+		case "HOURLY":
+			return t.Add(time.Duration(n) * time.Hour)
+		case "MONTHLY":
+			return t.AddDate(0, n, 0)
+		}
+		return t.AddDate(0, 0, n)
+	}
+
+	var start, end time.Time
+	setStart := func(t time.Time) {
+		if start.IsZero() || t.After(start) {
+			start = t
+		}
+	}
+	setEnd := func(t time.Time) {
+		if end.IsZero() || t.Before(end) {
+			end = t
+		}
+	}
+
+	if quals["period_start"] != nil {
+		for _, q := range quals["period_start"].Quals {
+			t := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				setStart(t)
+				setEnd(addPeriod(t, 1))
+			case ">", ">=":
+				setStart(t)
+			case "<":
+				setEnd(t)
+			case "<=":
+				setEnd(addPeriod(t, 1))
+			}
+		}
+	}
+	if quals["period_end"] != nil {
+		for _, q := range quals["period_end"].Quals {
+			t := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				setStart(addPeriod(t, -1))
+				setEnd(t)
+			case ">", ">=":
+				setStart(addPeriod(t, -1))
+			case "<", "<=":
+				setEnd(t)
+			}
+		}
+	}
+
+	return start, end
+}
+
+// getCEMetrics returns the metrics to query: those of the metrics qual if
+// set, else only those needed by the requested columns. Cost Explorer
+// requires at least one metric, so UnblendedCost is queried when no metric
+// column is requested.
+func getCEMetrics(d *plugin.QueryData) ([]string, error) {
+	if d.EqualsQuals["metrics"] != nil {
+		var metrics []string
+		if err := json.Unmarshal([]byte(d.EqualsQuals["metrics"].GetJsonbValue()), &metrics); err != nil {
+			return nil, fmt.Errorf("invalid metrics, expected an array of metric names: %v", err)
+		}
+		if len(metrics) > 0 {
+			return metrics, nil
+		}
+	}
+
+	requested := map[string]bool{}
+	for _, column := range d.QueryContext.Columns {
+		requested[column] = true
+	}
+
+	var metrics []string
+	for _, metric := range AllCostMetrics() {
+		for _, column := range ceMetricColumns[metric] {
+			if requested[column] {
+				metrics = append(metrics, metric)
+				break
+			}
+		}
+	}
+	if len(metrics) == 0 {
+		metrics = []string{"UnblendedCost"}
+	}
+
+	return metrics, nil
 }
 
 type CEQuals struct {
@@ -275,6 +542,9 @@ type CEQuals struct {
 	DimensionType2  string
 	TagKey1         string
 	TagKey2         string
+
+	CostCategoryName1 string
+	CostCategoryName2 string
 }
 
 func hydrateCostAndUsageQuals(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -288,5 +558,8 @@ func hydrateCostAndUsageQuals(ctx context.Context, d *plugin.QueryData, _ *plugi
 		DimensionType2:  d.EqualsQuals["dimension_type_2"].GetStringValue(),
 		TagKey1:         d.EqualsQuals["tag_key_1"].GetStringValue(),
 		TagKey2:         d.EqualsQuals["tag_key_2"].GetStringValue(),
+
+		CostCategoryName1: d.EqualsQuals["cost_category_name_1"].GetStringValue(),
+		CostCategoryName2: d.EqualsQuals["cost_category_name_2"].GetStringValue(),
 	}, nil
 }
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ceTimestampQuals(column string, operatorValues ...string) *plugin.KeyColumnQuals {
+	keyQuals := &plugin.KeyColumnQuals{Name: column}
+	for i := 0; i < len(operatorValues); i += 2 {
+		t, _ := time.Parse(time.RFC3339, operatorValues[i+1])
+		keyQuals.Quals = append(keyQuals.Quals, &quals.Qual{
+			Column:   column,
+			Operator: operatorValues[i],
+			Value:    &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(t)}},
+		})
+	}
+	return keyQuals
+}
+
+func TestGetCEDateInterval(t *testing.T) {
+	cases := []struct {
+		name        string
+		granularity string
+		quals       plugin.KeyColumnQualMap
+		start, end  string
+	}{
+		{"start range", "DAILY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", ">=", "2023-10-01T00:00:00Z", "<", "2023-10-08T00:00:00Z"),
+		}, "2023-10-01", "2023-10-08"},
+		{"start equals", "MONTHLY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", "=", "2023-01-01T00:00:00Z"),
+		}, "2023-01-01", "2023-02-01"},
+		{"inclusive start upper bound", "DAILY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", ">", "2023-10-01T00:00:00Z", "<=", "2023-10-07T00:00:00Z"),
+		}, "2023-10-01", "2023-10-08"},
+		{"end range", "MONTHLY", plugin.KeyColumnQualMap{
+			"period_end": ceTimestampQuals("period_end", ">=", "2023-03-01T00:00:00Z", "<=", "2023-06-01T00:00:00Z"),
+		}, "2023-02-01", "2023-06-01"},
+		{"end only", "DAILY", plugin.KeyColumnQualMap{
+			"period_end": ceTimestampQuals("period_end", "<", "2023-06-01T00:00:00Z"),
+		}, "2022-06-01", "2023-06-01"},
+		{"hourly", "HOURLY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", "=", "2023-10-01T10:00:00Z"),
+		}, "2023-10-01T10:00:00Z", "2023-10-01T11:00:00Z"},
+	}
+	for _, c := range cases {
+		interval := getCEDateInterval(c.quals, c.granularity)
+		if *interval.Start != c.start || *interval.End != c.end {
+			t.Errorf("%s: expected %s - %s, got %s - %s", c.name, c.start, c.end, *interval.Start, *interval.End)
+		}
+	}
+}
+
+func TestGetCEForecastDateInterval(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2023-10-12T15:04:05Z")
+	cases := []struct {
+		name        string
+		granularity string
+		quals       plugin.KeyColumnQualMap
+		start, end  string
+	}{
+		{"future range", "MONTHLY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", ">=", "2023-11-01T00:00:00Z", "<", "2024-02-01T00:00:00Z"),
+		}, "2023-11-01", "2024-02-01"},
+		{"past start", "DAILY", plugin.KeyColumnQualMap{
+			"period_start": ceTimestampQuals("period_start", ">=", "2023-10-01T00:00:00Z"),
+			"period_end":   ceTimestampQuals("period_end", "<=", "2023-10-20T00:00:00Z"),
+		}, "2023-10-12", "2023-10-20"},
+		{"past range", "DAILY", plugin.KeyColumnQualMap{
+			"period_end": ceTimestampQuals("period_end", "<=", "2023-10-01T00:00:00Z"),
+		}, "2023-10-12", "2023-10-01"},
+	}
+	for _, c := range cases {
+		interval := getCEForecastDateInterval(c.quals, c.granularity, now)
+		if *interval.Start != c.start || *interval.End != c.end {
+			t.Errorf("%s: expected %s - %s, got %s - %s", c.name, c.start, c.end, *interval.Start, *interval.End)
+		}
+	}
+}
+
+func TestCEForecastMetric(t *testing.T) {
+	cases := map[string]types.Metric{
+		"UNBLENDED_COST":        types.MetricUnblendedCost,
+		"net_amortized_cost":    types.MetricNetAmortizedCost,
+		"UnblendedCost":         types.MetricUnblendedCost,
+		"NormalizedUsageAmount": types.MetricNormalizedUsageAmount,
+		"UsageQuantity":         types.MetricUsageQuantity,
+	}
+	for metric, expected := range cases {
+		if actual := ceForecastMetric(metric); actual != expected {
+			t.Errorf("%s: expected %s, got %s", metric, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,579 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// pricingHoursPerMonth is the number of hours in a month AWS uses to convert
+// hourly prices to monthly ones, and the reverse.
+const pricingHoursPerMonth = 730
+
+// pricingIndexFamily is a product family indexed by the price index. Its
+// products are looked up by the given attributes, and only those matching all
+// the filters are indexed.
+type pricingIndexFamily struct {
+	Name       string
+	Attributes []string
+	Filters    map[string]string
+}
+
+// pricingIndexFamilies are the product families indexed per service. Only the
+// products needed to estimate the cost of resources are indexed, as the EC2
+// price list alone has millions of products.
+var pricingIndexFamilies = map[string][]pricingIndexFamily{
+	"AmazonEC2": {
+		{Name: "Compute Instance", Attributes: []string{"instanceType", "tenancy", "operatingSystem", "preInstalledSw", "licenseModel"}, Filters: map[string]string{"capacitystatus": "Used", "locationType": "AWS Region"}},
+		{Name: "Compute Instance (bare metal)", Attributes: []string{"instanceType", "tenancy", "operatingSystem", "preInstalledSw", "licenseModel"}, Filters: map[string]string{"capacitystatus": "Used", "locationType": "AWS Region"}},
+		{Name: "Storage", Attributes: []string{"volumeApiName"}, Filters: map[string]string{"locationType": "AWS Region"}},
+		{Name: "System Operation", Attributes: []string{"volumeApiName", "group"}, Filters: map[string]string{"locationType": "AWS Region"}},
+		{Name: "Provisioned Throughput", Attributes: []string{"volumeApiName"}, Filters: map[string]string{"locationType": "AWS Region"}},
+		{Name: "NAT Gateway", Filters: map[string]string{"locationType": "AWS Region"}},
+	},
+	"AmazonRDS": {
+		{Name: "Database Instance", Attributes: []string{"instanceType", "databaseEngine", "databaseEdition", "deploymentOption", "licenseModel", "storage"}},
+		{Name: "Database Storage", Attributes: []string{"volumeType", "databaseEngine", "deploymentOption"}},
+	},
+	"AmazonElastiCache": {
+		{Name: "Cache Instance", Attributes: []string{"instanceType", "cacheEngine"}},
+	},
+}
+
+// pricingIndex holds the on-demand prices of the products of a service in a
+// region. It is built once per connection and shared by all queries, either
+// from the Pricing API or from the bulk offer files.
+type pricingIndex struct {
+	// Products by product family
+	Products map[string][]pricingIndexProduct
+
+	mu sync.Mutex
+	// Prices by product family, unit and looked up attribute names, then by
+	// looked up attribute values, built on first use
+	lookups map[string]map[string]float64
+}
+
+// pricingIndexProduct is the first tier on-demand price of a product.
+type pricingIndexProduct struct {
+	Sku          string
+	Attributes   map[string]string
+	Unit         string
+	PricePerUnit float64
+}
+
+func newPricingIndex() *pricingIndex {
+	return &pricingIndex{
+		Products: map[string][]pricingIndexProduct{},
+		lookups:  map[string]map[string]float64{},
+	}
+}
+
+// add indexes the first tier on-demand price of the row, if its product
+// belongs to an indexed family of the service.
+func (i *pricingIndex) add(serviceCode string, row *PriceOutput) {
+	family := pricingIndexFamilyOf(serviceCode, row.Product)
+	if family == nil || row.Offer == nil || row.Offer.Term != "OnDemand" || row.Offer.PriceDimension == nil {
+		return
+	}
+	dimension := row.Offer.PriceDimension
+	if dimension.BeginRange != nil && *dimension.BeginRange != "0" {
+		return
+	}
+
+	attributes := map[string]string{}
+	for _, name := range family.Attributes {
+		attributes[name] = aws.ToString(pricingProductAttribute(row.Product, name))
+	}
+
+	for _, price := range dimension.PricePerUnit {
+		value, err := strconv.ParseFloat(aws.ToString(price), 64)
+		if err != nil {
+			continue
+		}
+		i.Products[family.Name] = append(i.Products[family.Name], pricingIndexProduct{
+			Sku:          aws.ToString(row.Product.Sku),
+			Attributes:   attributes,
+			Unit:         aws.ToString(dimension.Unit),
+			PricePerUnit: value,
+		})
+	}
+}
+
+// price returns the price per unit of the product of the family matching the
+// attributes, or nil if there is none. If several products match, the one
+// with the lowest SKU wins, so that lookups are stable across loads.
+func (i *pricingIndex) price(family string, unit string, attributes map[string]string) *float64 {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lookupKey := func(values map[string]string) string {
+		var key strings.Builder
+		for _, name := range names {
+			key.WriteString(values[name])
+			key.WriteByte(0)
+		}
+		return key.String()
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	indexKey := family + "\x00" + unit + "\x00" + strings.Join(names, "\x00")
+	lookup, ok := i.lookups[indexKey]
+	if !ok {
+		lookup = map[string]float64{}
+		products := i.Products[family]
+		sort.SliceStable(products, func(a, b int) bool { return products[a].Sku < products[b].Sku })
+		for _, product := range products {
+			if product.Unit != unit {
+				continue
+			}
+			key := lookupKey(product.Attributes)
+			if _, ok := lookup[key]; !ok {
+				lookup[key] = product.PricePerUnit
+			}
+		}
+		i.lookups[indexKey] = lookup
+	}
+
+	value, ok := lookup[lookupKey(attributes)]
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func pricingIndexFamilyOf(serviceCode string, product *Product) *pricingIndexFamily {
+	if product == nil || product.ProductFamily == nil {
+		return nil
+	}
+	for _, family := range pricingIndexFamilies[serviceCode] {
+		if family.Name != *product.ProductFamily {
+			continue
+		}
+		for name, value := range family.Filters {
+			if aws.ToString(pricingProductAttribute(product, name)) != value {
+				return nil
+			}
+		}
+		return &family
+	}
+	return nil
+}
+
+//// LOADING
+
+type pricingIndexKey struct {
+	ServiceCode string
+	Region      string
+}
+
+// The estimated cost columns need the pricing:GetProducts permission, which
+// many read only roles lack, so without it they are null rather than failing
+// the query.
+var pricingIndexIgnoreConfig = &plugin.IgnoreConfig{
+	ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessDeniedException"}),
+}
+
+// getPricingIndex returns the price index of the service in the region,
+// loading it on first use.
+func getPricingIndex(ctx context.Context, d *plugin.QueryData, serviceCode string, region string) (*pricingIndex, error) {
+	h := &plugin.HydrateData{Item: pricingIndexKey{ServiceCode: serviceCode, Region: region}}
+	i, err := getPricingIndexCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	return i.(*pricingIndex), nil
+}
+
+// Cached form of the price index, using the per-connection and parallel safe
+// Memoize() method.
+var getPricingIndexCached = plugin.HydrateFunc(getPricingIndexUncached).Memoize(memoize.WithCacheKeyFunction(getPricingIndexCacheKey))
+
+// The price index is per service and region, but Memoize() is per-connection,
+// so set up a custom cache key with the service and region in it.
+func getPricingIndexCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := h.Item.(pricingIndexKey)
+	return fmt.Sprintf("getPricingIndex-%s-%s", key.ServiceCode, key.Region), nil
+}
+
+// getPricingIndexUncached loads the price index from the bulk offer files if
+// the pricing_offer_file_path config argument is set, and otherwise from the
+// Pricing API. Do not call this directly, use getPricingIndex instead.
+func getPricingIndexUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := h.Item.(pricingIndexKey)
+	awsSpcConfig := GetConfig(d.Connection)
+
+	plugin.Logger(ctx).Debug("getPricingIndexUncached", "connection_name", d.Connection.Name, "service_code", key.ServiceCode, "region", key.Region, "status", "starting")
+
+	index := newPricingIndex()
+	var err error
+	if awsSpcConfig.PricingOfferFilePath != nil {
+		err = loadPricingIndexFromOfferFile(ctx, d, index, key)
+	} else {
+		err = loadPricingIndexFromAPI(ctx, d, index, key)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("getPricingIndexUncached", "connection_name", d.Connection.Name, "service_code", key.ServiceCode, "region", key.Region, "error", err)
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// loadPricingIndexFromOfferFile reads the offer file of the service in the
+// region. A missing offer file leaves the index empty, so that the costs in
+// the region are unknown.
+func loadPricingIndexFromOfferFile(ctx context.Context, d *plugin.QueryData, index *pricingIndex, key pricingIndexKey) error {
+	query := pricingOfferFileQuery{
+		Product: func(product *Product) bool {
+			return pricingIndexFamilyOf(key.ServiceCode, product) != nil
+		},
+		Terms: []string{"OnDemand"},
+	}
+	_, err := readPricingOfferFiles(ctx, d, key.ServiceCode, key.Region, query, func(row *PriceOutput) bool {
+		index.add(key.ServiceCode, row)
+		return true
+	})
+	return err
+}
+
+// loadPricingIndexFromAPI gets the products of each indexed family of the
+// service in the region.
+func loadPricingIndexFromAPI(ctx context.Context, d *plugin.QueryData, index *pricingIndex, key pricingIndexKey) error {
+	svc, err := PricingClient(ctx, d)
+	if err != nil {
+		return err
+	}
+	if svc == nil {
+		// Unsupported region, no prices
+		return nil
+	}
+
+	for _, family := range pricingIndexFamilies[key.ServiceCode] {
+		filters := []pricingTypes.Filter{
+			{Field: aws.String("productFamily"), Type: pricingTypes.FilterTypeTermMatch, Value: aws.String(family.Name)},
+			{Field: aws.String("regionCode"), Type: pricingTypes.FilterTypeTermMatch, Value: aws.String(key.Region)},
+		}
+		for name, value := range family.Filters {
+			filters = append(filters, pricingTypes.Filter{Field: aws.String(name), Type: pricingTypes.FilterTypeTermMatch, Value: aws.String(value)})
+		}
+
+		paginator := pricing.NewGetProductsPaginator(svc, &pricing.GetProductsInput{
+			ServiceCode:   aws.String(key.ServiceCode),
+			FormatVersion: aws.String("aws_v1"),
+			Filters:       filters,
+			MaxResults:    aws.Int32(100),
+		}, func(o *pricing.GetProductsPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range output.PriceList {
+				var priceList PriceList
+				if err := json.Unmarshal([]byte(item), &priceList); err != nil {
+					return err
+				}
+				if priceList.Terms["OnDemand"] == nil {
+					continue
+				}
+				for _, offer := range *priceList.Terms["OnDemand"] {
+					for _, dimension := range offer.PriceDimensions {
+						index.add(key.ServiceCode, &PriceOutput{
+							Product: priceList.Product,
+							Offer:   &OfferOutput{PriceDimension: dimension, Term: "OnDemand"},
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+//// COST ESTIMATES
+
+// pricingCostEstimate is the estimated on-demand cost of a resource, in the
+// currency of the price list, i.e. USD except in the China regions.
+type pricingCostEstimate struct {
+	EstimatedHourlyCost  *float64
+	EstimatedMonthlyCost *float64
+}
+
+func newPricingCostEstimateFromHourly(hourly float64) *pricingCostEstimate {
+	monthly := hourly * pricingHoursPerMonth
+	return &pricingCostEstimate{EstimatedHourlyCost: &hourly, EstimatedMonthlyCost: &monthly}
+}
+
+func newPricingCostEstimateFromMonthly(monthly float64) *pricingCostEstimate {
+	hourly := monthly / pricingHoursPerMonth
+	return &pricingCostEstimate{EstimatedHourlyCost: &hourly, EstimatedMonthlyCost: &monthly}
+}
+
+// pricingEc2Platform is the operating system and pre-installed software of an
+// EC2 instance, as named in the price list.
+type pricingEc2Platform struct {
+	OperatingSystem string
+	PreInstalledSw  string
+	LicenseModel    string
+}
+
+// pricingEc2Platforms maps the platform details of an instance, which is the
+// platform its usage is billed for, to the price list attributes.
+var pricingEc2Platforms = map[string]pricingEc2Platform{
+	"Linux/UNIX":                         {"Linux", "NA", "No License required"},
+	"Red Hat Enterprise Linux":           {"RHEL", "NA", "No License required"},
+	"Red Hat Enterprise Linux with HA":   {"Red Hat Enterprise Linux with HA", "NA", "No License required"},
+	"SUSE Linux":                         {"SUSE", "NA", "No License required"},
+	"Ubuntu Pro":                         {"Ubuntu Pro", "NA", "No License required"},
+	"Windows":                            {"Windows", "NA", "No License required"},
+	"Windows BYOL":                       {"Windows", "NA", "Bring your own license"},
+	"Windows with SQL Server Standard":   {"Windows", "SQL Std", "No License required"},
+	"Windows with SQL Server Enterprise": {"Windows", "SQL Ent", "No License required"},
+	"Windows with SQL Server Web":        {"Windows", "SQL Web", "No License required"},
+	"Linux with SQL Server Standard":     {"Linux", "SQL Std", "No License required"},
+	"Linux with SQL Server Enterprise":   {"Linux", "SQL Ent", "No License required"},
+	"Linux with SQL Server Web":          {"Linux", "SQL Web", "No License required"},
+}
+
+// estimateEc2InstanceCost returns the on-demand cost of running the instance,
+// which is zero if it isn't running. Spot instances and instances on Dedicated
+// Hosts, which are billed per host, have no estimate.
+func estimateEc2InstanceCost(index *pricingIndex, instanceType string, tenancy string, platformDetails string, lifecycle string, state string) *pricingCostEstimate {
+	if lifecycle == "spot" || tenancy == "host" {
+		return nil
+	}
+	switch state {
+	case "stopping", "stopped", "shutting-down", "terminated":
+		return newPricingCostEstimateFromHourly(0)
+	}
+
+	if platformDetails == "" {
+		platformDetails = "Linux/UNIX"
+	}
+	platform, ok := pricingEc2Platforms[platformDetails]
+	if !ok {
+		return nil
+	}
+
+	priceTenancy := "Shared"
+	if tenancy == "dedicated" {
+		priceTenancy = "Dedicated"
+	}
+
+	attributes := map[string]string{
+		"instanceType":    instanceType,
+		"tenancy":         priceTenancy,
+		"operatingSystem": platform.OperatingSystem,
+		"preInstalledSw":  platform.PreInstalledSw,
+		"licenseModel":    platform.LicenseModel,
+	}
+	for _, family := range []string{"Compute Instance", "Compute Instance (bare metal)"} {
+		if hourly := index.price(family, "Hrs", attributes); hourly != nil {
+			return newPricingCostEstimateFromHourly(*hourly)
+		}
+	}
+	return nil
+}
+
+// estimateEBSVolumeCost returns the cost of the storage, and of the IOPS and
+// throughput provisioned above the baseline included with the volume type.
+// I/O requests of magnetic volumes are billed by usage and not included.
+func estimateEBSVolumeCost(index *pricingIndex, volumeType string, size int32, iops int32, throughput int32) *pricingCostEstimate {
+	storage := index.price("Storage", "GB-Mo", map[string]string{"volumeApiName": volumeType})
+	if storage == nil {
+		return nil
+	}
+	monthly := *storage * float64(size)
+
+	// IOPS billed per tier of the price list, from the first IOPS above the
+	// baseline
+	type iopsTier struct {
+		Group string
+		Upto  int32
+	}
+	var tiers []iopsTier
+	var baseline int32
+	switch volumeType {
+	case "gp3":
+		baseline = 3000
+		tiers = []iopsTier{{"EBS IOPS", 0}}
+	case "io1":
+		tiers = []iopsTier{{"EBS IOPS", 0}}
+	case "io2":
+		tiers = []iopsTier{{"EBS IOPS", 32000}, {"EBS IOPS Tier 2", 64000}, {"EBS IOPS Tier 3", 0}}
+	}
+
+	from := baseline
+	for _, tier := range tiers {
+		if iops <= from {
+			break
+		}
+		to := iops
+		if tier.Upto > 0 && tier.Upto < to {
+			to = tier.Upto
+		}
+		price := index.price("System Operation", "IOPS-Mo", map[string]string{"volumeApiName": volumeType, "group": tier.Group})
+		if price == nil {
+			return nil
+		}
+		monthly += *price * float64(to-from)
+		from = to
+	}
+
+	// Throughput is provisioned in MiB/s and billed in GiB/s
+	if volumeType == "gp3" && throughput > 125 {
+		price := index.price("Provisioned Throughput", "GiBps-mo", map[string]string{"volumeApiName": volumeType})
+		if price == nil {
+			return nil
+		}
+		monthly += *price * float64(throughput-125) / 1024
+	}
+
+	return newPricingCostEstimateFromMonthly(monthly)
+}
+
+// pricingRDSEngines maps the engine of a DB instance to the database engine
+// and edition in the price list.
+var pricingRDSEngines = map[string][2]string{
+	"aurora":            {"Aurora MySQL", ""},
+	"aurora-mysql":      {"Aurora MySQL", ""},
+	"aurora-postgresql": {"Aurora PostgreSQL", ""},
+	"mariadb":           {"MariaDB", ""},
+	"mysql":             {"MySQL", ""},
+	"postgres":          {"PostgreSQL", ""},
+	"oracle-ee":         {"Oracle", "Enterprise"},
+	"oracle-ee-cdb":     {"Oracle", "Enterprise"},
+	"oracle-se2":        {"Oracle", "Standard Two"},
+	"oracle-se2-cdb":    {"Oracle", "Standard Two"},
+	"sqlserver-ee":      {"SQL Server", "Enterprise"},
+	"sqlserver-se":      {"SQL Server", "Standard"},
+	"sqlserver-ex":      {"SQL Server", "Express"},
+	"sqlserver-web":     {"SQL Server", "Web"},
+}
+
+// pricingRDSVolumeTypes maps the storage type of a DB instance to the volume
+// type in the price list.
+var pricingRDSVolumeTypes = map[string]string{
+	"gp2":      "General Purpose",
+	"gp3":      "General Purpose-GP3",
+	"io1":      "Provisioned IOPS",
+	"io2":      "Provisioned IOPS-IO2",
+	"standard": "Magnetic",
+}
+
+// estimateRDSDBInstanceCost returns the cost of the instance hours and of the
+// allocated storage. The instance hours of a stopped instance are not billed.
+// Aurora storage, provisioned IOPS and backups are not included.
+func estimateRDSDBInstanceCost(index *pricingIndex, instanceClass string, engine string, licenseModel string, multiAZ bool, storageType string, allocatedStorage int32, status string) *pricingCostEstimate {
+	priceEngine, ok := pricingRDSEngines[engine]
+	if !ok {
+		return nil
+	}
+
+	deploymentOption := "Single-AZ"
+	if multiAZ && !strings.HasPrefix(engine, "aurora") {
+		deploymentOption = "Multi-AZ"
+	}
+
+	var hourly float64
+	if status != "stopped" {
+		priceLicenseModel := "No license required"
+		switch licenseModel {
+		case "license-included":
+			priceLicenseModel = "License included"
+		case "bring-your-own-license":
+			priceLicenseModel = "Bring your own license"
+		}
+		storage := "EBS Only"
+		if storageType == "aurora-iopt1" {
+			storage = "Aurora IO Optimization Mode"
+		}
+
+		price := index.price("Database Instance", "Hrs", map[string]string{
+			"instanceType":     instanceClass,
+			"databaseEngine":   priceEngine[0],
+			"databaseEdition":  priceEngine[1],
+			"deploymentOption": deploymentOption,
+			"licenseModel":     priceLicenseModel,
+			"storage":          storage,
+		})
+		if price == nil {
+			return nil
+		}
+		hourly = *price
+	}
+
+	var monthly float64
+	if volumeType, ok := pricingRDSVolumeTypes[storageType]; ok && allocatedStorage > 0 {
+		price := index.price("Database Storage", "GB-Mo", map[string]string{
+			"volumeType":       volumeType,
+			"databaseEngine":   priceEngine[0],
+			"deploymentOption": deploymentOption,
+		})
+		if price == nil {
+			return nil
+		}
+		monthly = *price * float64(allocatedStorage)
+	}
+
+	return newPricingCostEstimateFromMonthly(hourly*pricingHoursPerMonth + monthly)
+}
+
+// estimateElastiCacheClusterCost returns the cost of the nodes of the cluster.
+func estimateElastiCacheClusterCost(index *pricingIndex, nodeType string, engine string, numNodes int32) *pricingCostEstimate {
+	cacheEngines := map[string]string{
+		"memcached": "Memcached",
+		"redis":     "Redis",
+		"valkey":    "Valkey",
+	}
+	cacheEngine, ok := cacheEngines[engine]
+	if !ok {
+		return nil
+	}
+
+	price := index.price("Cache Instance", "Hrs", map[string]string{"instanceType": nodeType, "cacheEngine": cacheEngine})
+	if price == nil {
+		return nil
+	}
+	return newPricingCostEstimateFromHourly(*price * float64(numNodes))
+}
+
+// estimateVpcNatGatewayCost returns the cost of the NAT gateway hours, which
+// is zero once it is deleted. Data processed is billed by usage and not
+// included.
+func estimateVpcNatGatewayCost(index *pricingIndex, state string) *pricingCostEstimate {
+	switch state {
+	case "deleting", "deleted", "failed":
+		return newPricingCostEstimateFromHourly(0)
+	}
+
+	price := index.price("NAT Gateway", "Hrs", map[string]string{})
+	if price == nil {
+		return nil
+	}
+	return newPricingCostEstimateFromHourly(*price)
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+const testPricingOfferFile = `{
+  "formatVersion": "v1.0",
+  "offerCode": "AmazonEC2",
+  "version": "20231012",
+  "products": {
+    "SKU1": {"sku": "SKU1", "productFamily": "Compute Instance", "attributes": {"instanceType": "m5.large", "tenancy": "Shared", "operatingSystem": "Linux", "preInstalledSw": "NA", "licenseModel": "No License required", "capacitystatus": "Used", "locationType": "AWS Region"}},
+    "SKU2": {"sku": "SKU2", "productFamily": "Compute Instance", "attributes": {"instanceType": "m5.large", "tenancy": "Shared", "operatingSystem": "Linux", "preInstalledSw": "NA", "licenseModel": "No License required", "capacitystatus": "UnusedCapacityReservation", "locationType": "AWS Region"}},
+    "SKU3": {"sku": "SKU3", "productFamily": "Storage", "attributes": {"volumeApiName": "gp3", "locationType": "AWS Region"}},
+    "SKU4": {"sku": "SKU4", "productFamily": "System Operation", "attributes": {"volumeApiName": "gp3", "group": "EBS IOPS", "locationType": "AWS Region"}},
+    "SKU5": {"sku": "SKU5", "productFamily": "Provisioned Throughput", "attributes": {"volumeApiName": "gp3", "locationType": "AWS Region"}},
+    "SKU6": {"sku": "SKU6", "productFamily": "Storage", "attributes": {"volumeApiName": "io2", "locationType": "AWS Region"}},
+    "SKU7": {"sku": "SKU7", "productFamily": "System Operation", "attributes": {"volumeApiName": "io2", "group": "EBS IOPS", "locationType": "AWS Region"}},
+    "SKU8": {"sku": "SKU8", "productFamily": "System Operation", "attributes": {"volumeApiName": "io2", "group": "EBS IOPS Tier 2", "locationType": "AWS Region"}},
+    "SKU9": {"sku": "SKU9", "productFamily": "NAT Gateway", "attributes": {"locationType": "AWS Region"}},
+    "SKU10": {"sku": "SKU10", "productFamily": "Data Transfer", "attributes": {}}
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU1": {"SKU1.JRTCKXETXF": {"offerTermCode": "JRTCKXETXF", "sku": "SKU1", "effectiveDate": "2023-10-01T00:00:00Z", "priceDimensions": {"SKU1.JRTCKXETXF.6YS6EN2CT7": {"rateCode": "SKU1.JRTCKXETXF.6YS6EN2CT7", "unit": "Hrs", "beginRange": "0", "endRange": "Inf", "pricePerUnit": {"USD": "0.0960000000"}}}, "termAttributes": {}}},
+      "SKU2": {"SKU2.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0000000000"}}}}},
+      "SKU3": {"SKU3.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.0800000000"}}}}},
+      "SKU4": {"SKU4.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "IOPS-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.0050000000"}}}}},
+      "SKU5": {"SKU5.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "GiBps-mo", "beginRange": "0", "pricePerUnit": {"USD": "40.9600000000"}}}}},
+      "SKU6": {"SKU6.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.1250000000"}}}}},
+      "SKU7": {"SKU7.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "IOPS-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.0650000000"}}}}},
+      "SKU8": {"SKU8.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "IOPS-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.0455000000"}}}}},
+      "SKU9": {"SKU9.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0450000000"}}}}},
+      "SKU10": {"SKU10.JRTCKXETXF": {"priceDimensions": {"a": {"unit": "GB", "beginRange": "0", "pricePerUnit": {"USD": "0.0900000000"}}}}}
+    },
+    "Reserved": {
+      "SKU1": {"SKU1.4NA7Y494T4": {"priceDimensions": {"a": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0600000000"}}}}}
+    }
+  }
+}`
+
+func testPricingIndex(t *testing.T) *pricingIndex {
+	index := newPricingIndex()
+	_, err := decodePricingOfferFile(strings.NewReader(testPricingOfferFile), pricingOfferFileQuery{Terms: []string{"OnDemand"}}, func(row *PriceOutput) bool {
+		index.add("AmazonEC2", row)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return index
+}
+
+func TestPricingIndex(t *testing.T) {
+	index := testPricingIndex(t)
+
+	if len(index.Products["Compute Instance"]) != 1 {
+		t.Errorf("expected only the used capacity product to be indexed, got %+v", index.Products["Compute Instance"])
+	}
+	if len(index.Products["Data Transfer"]) != 0 {
+		t.Errorf("expected families not needed to be skipped")
+	}
+
+	if price := index.price("Storage", "GB-Mo", map[string]string{"volumeApiName": "gp3"}); price == nil || *price != 0.08 {
+		t.Errorf("unexpected gp3 storage price %v", price)
+	}
+	if price := index.price("Storage", "Hrs", map[string]string{"volumeApiName": "gp3"}); price != nil {
+		t.Errorf("expected no price for another unit, got %v", *price)
+	}
+	if price := index.price("Storage", "GB-Mo", map[string]string{"volumeApiName": "st1"}); price != nil {
+		t.Errorf("expected no st1 storage price, got %v", *price)
+	}
+
+	if _, err := decodePricingOfferFile(strings.NewReader(`{"products": {"SKU1": `), pricingOfferFileQuery{}, func(*PriceOutput) bool { return true }); err == nil {
+		t.Errorf("expected an error for a truncated offer file")
+	}
+}
+
+func TestPricingCostEstimates(t *testing.T) {
+	index := testPricingIndex(t)
+
+	cases := []struct {
+		name     string
+		estimate *pricingCostEstimate
+		monthly  *float64
+	}{
+		{"running instance", estimateEc2InstanceCost(index, "m5.large", "default", "Linux/UNIX", "", "running"), pricingTestFloat(0.096 * 730)},
+		{"stopped instance", estimateEc2InstanceCost(index, "m5.large", "default", "Linux/UNIX", "", "stopped"), pricingTestFloat(0)},
+		{"spot instance", estimateEc2InstanceCost(index, "m5.large", "default", "Linux/UNIX", "spot", "running"), nil},
+		{"unknown instance type", estimateEc2InstanceCost(index, "m5.xlarge", "default", "Linux/UNIX", "", "running"), nil},
+		{"gp3 baseline", estimateEBSVolumeCost(index, "gp3", 100, 3000, 125), pricingTestFloat(8)},
+		{"gp3 provisioned", estimateEBSVolumeCost(index, "gp3", 100, 4000, 253), pricingTestFloat(8 + 5 + 40.96*128/1024)},
+		{"io2 tiered", estimateEBSVolumeCost(index, "io2", 100, 40000, 0), pricingTestFloat(12.5 + 0.065*32000 + 0.0455*8000)},
+		{"io2 missing tier", estimateEBSVolumeCost(index, "io2", 100, 70000, 0), nil},
+		{"nat gateway", estimateVpcNatGatewayCost(index, "available"), pricingTestFloat(0.045 * 730)},
+		{"deleted nat gateway", estimateVpcNatGatewayCost(index, "deleted"), pricingTestFloat(0)},
+	}
+	for _, c := range cases {
+		if c.monthly == nil {
+			if c.estimate != nil {
+				t.Errorf("%s: expected no estimate, got %v", c.name, *c.estimate.EstimatedMonthlyCost)
+			}
+			continue
+		}
+		if c.estimate == nil {
+			t.Errorf("%s: expected an estimate of %v, got none", c.name, *c.monthly)
+			continue
+		}
+		if math.Abs(*c.estimate.EstimatedMonthlyCost-*c.monthly) > 1e-9 || math.Abs(*c.estimate.EstimatedHourlyCost*730-*c.monthly) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v monthly and %v hourly", c.name, *c.monthly, *c.estimate.EstimatedMonthlyCost, *c.estimate.EstimatedHourlyCost)
+		}
+	}
+}
+
+func pricingTestFloat(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,523 @@
+package aws
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The bulk offer files are read from a mirror of
+// https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/, set with the
+// pricing_offer_file_path config argument. It is either a local directory or
+// an S3 path, whose files are downloaded to a local cache directory and
+// downloaded again only once their ETag changes. Each service has:
+//   - <service code>/current/region_index.json, listing its regions
+//   - <service code>/current/index.json or index.csv, its offer file for all regions
+//   - <service code>/current/<region>/index.json or index.csv, its offer file for a region
+
+// pricingOfferFileQuery selects the rows read from an offer file.
+type pricingOfferFileQuery struct {
+	// Product returns whether the rows of the product are needed, or nil for
+	// all products
+	Product func(product *Product) bool
+	// Terms are the term types needed, e.g. OnDemand, or empty for all
+	Terms []string
+}
+
+func (q pricingOfferFileQuery) includesProduct(product *Product) bool {
+	return q.Product == nil || q.Product(product)
+}
+
+func (q pricingOfferFileQuery) includesTerm(term string) bool {
+	if len(q.Terms) == 0 {
+		return true
+	}
+	for _, t := range q.Terms {
+		if strings.EqualFold(t, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// readPricingOfferFiles streams the rows of the offer file of the service in
+// the region, or in all regions if region is empty. It returns false once no
+// more rows are needed, and reads nothing if there is no offer file.
+func readPricingOfferFiles(ctx context.Context, d *plugin.QueryData, serviceCode string, region string, query pricingOfferFileQuery, fn func(row *PriceOutput) bool) (bool, error) {
+	if region != "" {
+		return readPricingOfferFile(ctx, d, path.Join(serviceCode, "current", region), query, fn)
+	}
+
+	// Prefer the offer file of all regions, and fall back to the offer file
+	// of each region listed in the region index
+	more, found, err := readPricingOfferFileIfExists(ctx, d, path.Join(serviceCode, "current"), query, fn)
+	if err != nil || found {
+		return more, err
+	}
+
+	regions, err := listPricingOfferFileRegions(ctx, d, serviceCode)
+	if err != nil {
+		return false, err
+	}
+	for _, region := range regions {
+		more, err := readPricingOfferFile(ctx, d, path.Join(serviceCode, "current", region), query, fn)
+		if err != nil || !more {
+			return more, err
+		}
+	}
+	return true, nil
+}
+
+func readPricingOfferFile(ctx context.Context, d *plugin.QueryData, dir string, query pricingOfferFileQuery, fn func(row *PriceOutput) bool) (bool, error) {
+	more, found, err := readPricingOfferFileIfExists(ctx, d, dir, query, fn)
+	if err == nil && !found {
+		plugin.Logger(ctx).Debug("readPricingOfferFile", "offer file not found", dir)
+	}
+	return more, err
+}
+
+// readPricingOfferFileIfExists reads the JSON or CSV offer file in the
+// directory, and returns whether there is one.
+func readPricingOfferFileIfExists(ctx context.Context, d *plugin.QueryData, dir string, query pricingOfferFileQuery, fn func(row *PriceOutput) bool) (bool, bool, error) {
+	for _, format := range []string{"json", "csv"} {
+		name := path.Join(dir, "index."+format)
+		file, err := openPricingOfferFile(ctx, d, name)
+		if err != nil {
+			return false, false, err
+		}
+		if file == nil {
+			continue
+		}
+
+		var more bool
+		if format == "json" {
+			more, err = decodePricingOfferFile(file, query, fn)
+		} else {
+			more, err = decodePricingOfferCsvFile(file, query, fn)
+		}
+		file.Close()
+		if err != nil {
+			return false, true, fmt.Errorf("failed to read offer file %s: %v", name, err)
+		}
+		return more, true, nil
+	}
+	return true, false, nil
+}
+
+// listPricingOfferFileRegions returns the regions of the region index of the
+// service, or none if there is no region index.
+func listPricingOfferFileRegions(ctx context.Context, d *plugin.QueryData, serviceCode string) ([]string, error) {
+	name := path.Join(serviceCode, "current", "region_index.json")
+	file, err := openPricingOfferFile(ctx, d, name)
+	if err != nil || file == nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var regionIndex struct {
+		Regions map[string]struct {
+			RegionCode string `json:"regionCode"`
+		} `json:"regions"`
+	}
+	if err := json.NewDecoder(file).Decode(&regionIndex); err != nil {
+		return nil, fmt.Errorf("failed to read region index %s: %v", name, err)
+	}
+
+	var regions []string
+	for region := range regionIndex.Regions {
+		regions = append(regions, region)
+	}
+	return regions, nil
+}
+
+// openPricingOfferFile opens the file of the offer file mirror, or returns
+// nil if it doesn't exist.
+func openPricingOfferFile(ctx context.Context, d *plugin.QueryData, name string) (io.ReadCloser, error) {
+	awsSpcConfig := GetConfig(d.Connection)
+	if awsSpcConfig.PricingOfferFilePath == nil {
+		return nil, nil
+	}
+
+	root := *awsSpcConfig.PricingOfferFilePath
+	local := filepath.Join(root, filepath.FromSlash(name))
+	if strings.HasPrefix(root, "s3://") {
+		bucket, prefix, _ := strings.Cut(strings.TrimPrefix(root, "s3://"), "/")
+		var err error
+		local, err = cachePricingOfferFile(ctx, d, bucket, path.Join(prefix, name))
+		if err != nil || local == "" {
+			return nil, err
+		}
+	}
+
+	file, err := os.Open(local)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+// cachePricingOfferFile downloads the object to the offer file cache, unless
+// the cached file has the same ETag, and returns its path. It returns an empty
+// path if the object doesn't exist.
+func cachePricingOfferFile(ctx context.Context, d *plugin.QueryData, bucket string, key string) (string, error) {
+	awsSpcConfig := GetConfig(d.Connection)
+	cacheDir := filepath.Join(os.TempDir(), "steampipe-plugin-aws", "pricing")
+	if awsSpcConfig.PricingOfferFileCachePath != nil {
+		cacheDir = *awsSpcConfig.PricingOfferFileCachePath
+	}
+	local := filepath.Join(cacheDir, bucket, filepath.FromSlash(key))
+
+	svc, err := s3ClientForBucket(ctx, d, nil, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := svc.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	etag := aws.ToString(head.ETag)
+
+	if cached, err := os.ReadFile(local + ".etag"); err == nil && string(cached) == etag {
+		if _, err := os.Stat(local); err == nil {
+			return local, nil
+		}
+	}
+
+	plugin.Logger(ctx).Debug("cachePricingOfferFile", "bucket", bucket, "key", key, "etag", etag, "downloading to", local)
+
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket:  aws.String(bucket),
+		Key:     aws.String(key),
+		IfMatch: head.ETag,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer object.Body.Close()
+
+	// Download to a temporary file, renamed once complete, so that concurrent
+	// queries never read a partial file
+	if err := os.MkdirAll(filepath.Dir(local), 0700); err != nil {
+		return "", err
+	}
+	download, err := os.CreateTemp(filepath.Dir(local), ".download-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(download.Name())
+
+	if _, err := io.Copy(download, object.Body); err != nil {
+		download.Close()
+		return "", err
+	}
+	if err := download.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(download.Name(), local); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(local+".etag", []byte(etag), 0600); err != nil {
+		return "", err
+	}
+
+	return local, nil
+}
+
+//// DECODING
+
+// decodePricingOfferFile streams the rows of a JSON offer file, without
+// loading it whole as the EC2 offer file of a region alone is several hundred
+// megabytes. Offer files list all their products before their terms. It
+// returns false once no more rows are needed.
+func decodePricingOfferFile(r io.Reader, query pricingOfferFileQuery, fn func(row *PriceOutput) bool) (bool, error) {
+	dec := json.NewDecoder(r)
+
+	var serviceCode, version *string
+	var publicationDate *time.Time
+	products := map[string]*Product{}
+	more := true
+
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return false, err
+	}
+	for more && dec.More() {
+		field, err := dec.Token()
+		if err != nil {
+			return false, err
+		}
+
+		switch field {
+		case "offerCode":
+			err = dec.Decode(&serviceCode)
+		case "version":
+			err = dec.Decode(&version)
+		case "publicationDate":
+			err = dec.Decode(&publicationDate)
+		case "products":
+			err = decodeJSONObjectEntries(dec, func(sku string) (bool, error) {
+				var product Product
+				if err := dec.Decode(&product); err != nil {
+					return false, err
+				}
+				if product.Sku == nil {
+					product.Sku = aws.String(sku)
+				}
+				if query.includesProduct(&product) {
+					products[sku] = &product
+				}
+				return true, nil
+			})
+		case "terms":
+			err = decodeJSONObjectEntries(dec, func(termType string) (bool, error) {
+				if !query.includesTerm(termType) {
+					return true, skipJSONValue(dec)
+				}
+				err := decodeJSONObjectEntries(dec, func(sku string) (bool, error) {
+					product, ok := products[sku]
+					if !ok {
+						return true, skipJSONValue(dec)
+					}
+					var term Term
+					if err := dec.Decode(&term); err != nil {
+						return false, err
+					}
+					for _, offer := range term {
+						for _, dimension := range offer.PriceDimensions {
+							more = fn(&PriceOutput{
+								Product:         product,
+								ServiceCode:     serviceCode,
+								Version:         version,
+								PublicationDate: publicationDate,
+								Offer:           &OfferOutput{PriceDimension: dimension, Term: termType, EffectiveDate: offer.EffectiveDate, OfferTermCode: offer.OfferTermCode, TermAttributes: offer.TermAttributes},
+							})
+							if !more {
+								return false, nil
+							}
+						}
+					}
+					return true, nil
+				})
+				return more, err
+			})
+		default:
+			err = skipJSONValue(dec)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	if !more {
+		return false, nil
+	}
+
+	return true, expectJSONDelim(dec, '}')
+}
+
+// decodeJSONObjectEntries calls fn with the key of each entry of the next JSON
+// object, which must decode the entry value, until fn returns false.
+func decodeJSONObjectEntries(dec *json.Decoder, fn func(key string) (bool, error)) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		more, err := fn(token.(string))
+		if err != nil || !more {
+			return err
+		}
+	}
+	return expectJSONDelim(dec, '}')
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s, got %v", delim, token)
+	}
+	return nil
+}
+
+// skipJSONValue skips the next JSON value, without decoding it.
+func skipJSONValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// decodePricingOfferCsvFile streams the rows of a CSV offer file. It starts
+// with the metadata of the offer, e.g. "Version","20231012184521", followed by
+// a header and a line per price dimension.
+func decodePricingOfferCsvFile(r io.Reader, query pricingOfferFileQuery, fn func(row *PriceOutput) bool) (bool, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = -1
+
+	var serviceCode, version *string
+	var publicationDate *time.Time
+	var header []string
+	for header == nil {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if len(record) < 2 {
+			continue
+		}
+		switch record[0] {
+		case "OfferCode":
+			serviceCode = aws.String(record[1])
+		case "Version":
+			version = aws.String(record[1])
+		case "Publication Date":
+			if t, err := time.Parse(time.RFC3339, record[1]); err == nil {
+				publicationDate = &t
+			}
+		case "SKU":
+			header = record
+		}
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		product := &Product{Attributes: map[string]*string{}}
+		offer := &OfferOutput{PriceDimension: &PriceDimension{}, TermAttributes: &TermAttributes{}}
+		var price, currency string
+		for i, value := range record {
+			if i >= len(header) || value == "" {
+				continue
+			}
+			v := aws.String(value)
+			switch header[i] {
+			case "SKU":
+				product.Sku = v
+			case "OfferTermCode":
+				offer.OfferTermCode = v
+			case "RateCode":
+				offer.PriceDimension.RateCode = v
+			case "TermType":
+				offer.Term = value
+			case "PriceDescription":
+				offer.PriceDimension.Description = v
+			case "EffectiveDate":
+				if t, err := time.Parse("2006-01-02", value); err == nil {
+					offer.EffectiveDate = &t
+				}
+			case "StartingRange":
+				offer.PriceDimension.BeginRange = v
+			case "EndingRange":
+				offer.PriceDimension.EndRange = v
+			case "Unit":
+				offer.PriceDimension.Unit = v
+			case "PricePerUnit":
+				price = value
+			case "Currency":
+				currency = value
+			case "LeaseContractLength":
+				offer.TermAttributes.LeaseContractLength = v
+			case "PurchaseOption":
+				offer.TermAttributes.PurchaseOption = v
+			case "OfferingClass":
+				offer.TermAttributes.OfferingClass = v
+			case "Product Family":
+				product.ProductFamily = v
+			default:
+				product.Attributes[pricingCsvAttributeName(header[i])] = v
+			}
+		}
+		offer.PriceDimension.PricePerUnit = map[string]*string{currency: aws.String(price)}
+
+		if !query.includesTerm(offer.Term) || !query.includesProduct(product) {
+			continue
+		}
+		if !fn(&PriceOutput{Product: product, ServiceCode: serviceCode, Version: version, PublicationDate: publicationDate, Offer: offer}) {
+			return false, nil
+		}
+	}
+}
+
+// pricingCsvAttributeName maps a CSV offer file column to the name of the
+// attribute in JSON offer files, e.g. "Instance Type" to instanceType. Some
+// differ in case only, e.g. "Volume API Name" maps to volumeAPIName but is
+// volumeApiName in JSON, so attributes are looked up ignoring case.
+func pricingCsvAttributeName(column string) string {
+	var b strings.Builder
+	for _, r := range column {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if b.Len() == 0 {
+				r = unicode.ToLower(r)
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// pricingProductAttribute returns the value of the attribute of the product.
+// Attribute names are looked up ignoring case, as some differ in case between
+// the JSON and CSV offer files.
+func pricingProductAttribute(product *Product, name string) *string {
+	if value, ok := product.Attributes[name]; ok {
+		return value
+	}
+	for attribute, value := range product.Attributes {
+		if strings.EqualFold(attribute, name) {
+			return value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const testPricingOfferCsvFile = `"FormatVersion","v1.0"
+"Disclaimer","This pricing list is for informational purposes only."
+"Publication Date","2023-10-12T18:45:21Z"
+"Version","20231012184521"
+"OfferCode","AmazonEC2"
+"SKU","OfferTermCode","RateCode","TermType","PriceDescription","EffectiveDate","StartingRange","EndingRange","Unit","PricePerUnit","Currency","LeaseContractLength","PurchaseOption","OfferingClass","Product Family","serviceCode","Location Type","Region Code","Instance Type","Operating System","Pre Installed S/W","CapacityStatus","Volume API Name"
+"SKU1","JRTCKXETXF","SKU1.JRTCKXETXF.6YS6EN2CT7","OnDemand","$0.096 per On Demand Linux m5.large Instance Hour","2023-10-01","0","Inf","Hrs","0.0960000000","USD","","","","Compute Instance","AmazonEC2","AWS Region","us-east-1","m5.large","Linux","NA","Used",""
+"SKU1","4NA7Y494T4","SKU1.4NA7Y494T4.6YS6EN2CT7","Reserved","Linux/UNIX (Amazon VPC), m5.large reserved instance applied","2023-10-01","0","Inf","Hrs","0.0600000000","USD","1yr","No Upfront","standard","Compute Instance","AmazonEC2","AWS Region","us-east-1","m5.large","Linux","NA","Used",""
+"SKU3","JRTCKXETXF","SKU3.JRTCKXETXF.6YS6EN2CT7","OnDemand","$0.08 per GB-month of General Purpose (gp3) provisioned storage","2023-10-01","0","Inf","GB-Mo","0.0800000000","USD","","","","Storage","AmazonEC2","AWS Region","us-east-1","","","","","gp3"
+`
+
+func TestDecodePricingOfferCsvFile(t *testing.T) {
+	var rows []*PriceOutput
+	more, err := decodePricingOfferCsvFile(strings.NewReader(testPricingOfferCsvFile), pricingOfferFileQuery{}, func(row *PriceOutput) bool {
+		rows = append(rows, row)
+		return true
+	})
+	if err != nil || !more {
+		t.Fatalf("unexpected result %v: %v", more, err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	row := rows[1]
+	if *row.ServiceCode != "AmazonEC2" || *row.Version != "20231012184521" || row.PublicationDate.Format("2006-01-02") != "2023-10-12" {
+		t.Errorf("unexpected offer metadata %v %v %v", *row.ServiceCode, *row.Version, row.PublicationDate)
+	}
+	if *row.Product.Sku != "SKU1" || *row.Product.ProductFamily != "Compute Instance" || row.Offer.Term != "Reserved" || *row.Offer.TermAttributes.PurchaseOption != "No Upfront" {
+		t.Errorf("unexpected row %+v %+v", row.Product, row.Offer)
+	}
+	if *row.Offer.PriceDimension.PricePerUnit["USD"] != "0.0600000000" || row.Offer.EffectiveDate.Format("2006-01-02") != "2023-10-01" {
+		t.Errorf("unexpected price dimension %+v", row.Offer.PriceDimension)
+	}
+
+	// CSV columns map to the JSON attribute names, ignoring case
+	for name, expected := range map[string]string{"regionCode": "us-east-1", "instanceType": "m5.large", "preInstalledSw": "NA", "capacitystatus": "Used"} {
+		if value := pricingProductAttribute(row.Product, name); value == nil || *value != expected {
+			t.Errorf("expected attribute %s to be %s, got %v", name, expected, value)
+		}
+	}
+	if _, ok := rows[2].Product.Attributes["instanceType"]; ok {
+		t.Errorf("expected empty columns to be skipped")
+	}
+
+	// The price index reads CSV offer files too
+	index := newPricingIndex()
+	_, err = decodePricingOfferCsvFile(strings.NewReader(testPricingOfferCsvFile), pricingOfferFileQuery{Terms: []string{"OnDemand"}}, func(row *PriceOutput) bool {
+		index.add("AmazonEC2", row)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if estimate := estimateEc2InstanceCost(index, "m5.large", "default", "", "", "running"); estimate != nil {
+		t.Errorf("expected no estimate without the tenancy and license model attributes, got %v", *estimate.EstimatedHourlyCost)
+	}
+	if estimate := estimateEBSVolumeCost(index, "gp3", 10, 3000, 125); estimate == nil || *estimate.EstimatedMonthlyCost != 0.8 {
+		t.Errorf("unexpected gp3 estimate %v", estimate)
+	}
+}
+
+func TestDecodePricingOfferFileQuery(t *testing.T) {
+	filters := []types.Filter{
+		{Field: aws.String("productFamily"), Value: aws.String("Compute Instance")},
+		{Field: aws.String("capacityStatus"), Value: aws.String("Used")},
+	}
+	query := pricingOfferFileQuery{
+		Product: func(product *Product) bool {
+			return pricingProductMatchesFilters(product, filters)
+		},
+		Terms: []string{"OnDemand"},
+	}
+
+	var skus []string
+	_, err := decodePricingOfferFile(strings.NewReader(testPricingOfferFile), query, func(row *PriceOutput) bool {
+		skus = append(skus, *row.Product.Sku)
+		if row.Offer.Term != "OnDemand" || *row.ServiceCode != "AmazonEC2" || *row.Version != "20231012" {
+			t.Errorf("unexpected row %+v", row)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(skus, ",") != "SKU1" {
+		t.Errorf("unexpected products %v", skus)
+	}
+
+	// Reading stops once no more rows are needed
+	count := 0
+	more, err := decodePricingOfferFile(strings.NewReader(testPricingOfferFile), pricingOfferFileQuery{}, func(row *PriceOutput) bool {
+		count++
+		return count < 2
+	})
+	if err != nil || more || count != 2 {
+		t.Errorf("expected reading to stop after 2 rows, got %d rows, more %v: %v", count, more, err)
+	}
+}
@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// timestampQualRange returns the time range requested by the quals on the
+// timestamp column. Either end may be nil.
+func timestampQualRange(quals plugin.KeyColumnQualMap) (*time.Time, *time.Time) {
+	var startTime, endTime *time.Time
+	if quals["timestamp"] != nil {
+		for _, q := range quals["timestamp"].Quals {
+			ts := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime, endTime = &ts, &ts
+			case ">=", ">":
+				startTime = &ts
+			case "<", "<=":
+				endTime = &ts
+			}
+		}
+	}
+	return startTime, endTime
+}
+
+// s3ClientForBucket returns an S3 client for the region the bucket resides in.
+func s3ClientForBucket(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, bucketName string) (*s3.Client, error) {
+	bucketRegion, err := s3BucketRegion(ctx, d, h, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return S3Client(ctx, d, bucketRegion)
+}
+
+// s3BucketRegion returns the region the bucket resides in. The region is
+// cached per connection and account, as buckets of the same name may be read
+// by connections to different partitions.
+func s3BucketRegion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, bucketName string) (string, error) {
+	c, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return "", err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	cacheKey := fmt.Sprintf("s3BucketRegion-%s-%s-%s", d.Connection.Name, commonColumnData.AccountId, bucketName)
+
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	clientRegion, err := getDefaultRegion(ctx, d, h)
+	if err != nil {
+		return "", err
+	}
+	svc, err := S3Client(ctx, d, clientRegion)
+	if err != nil {
+		return "", err
+	}
+
+	location, err := svc.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		return "", err
+	}
+
+	// Buckets in us-east-1 have a LocationConstraint of null, and buckets in
+	// eu-west-1 created through the API can return a location of "EU"
+	bucketRegion := string(location.LocationConstraint)
+	switch bucketRegion {
+	case "":
+		bucketRegion = "us-east-1"
+	case "EU":
+		bucketRegion = "eu-west-1"
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, bucketRegion)
+
+	return bucketRegion, nil
+}
+
+// listS3CommonPrefixes returns the "directories" directly below the given prefix.
+func listS3CommonPrefixes(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string, prefix string) ([]string, error) {
+	prefixes := []string{}
+	input := &s3.ListObjectsV2Input{
+		Bucket:    aws.String(bucketName),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range output.CommonPrefixes {
+			prefixes = append(prefixes, *p.Prefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+// s3DailyLogPrefixes prunes a log prefix to the days within the requested time
+// range, using layout to format each day, e.g. "2006/01/02/" for logs
+// partitioned as <yyyy>/<mm>/<dd>/. Without a start time the whole prefix is
+// listed.
+func s3DailyLogPrefixes(prefix string, layout string, startTime, endTime *time.Time) []string {
+	if startTime == nil {
+		return []string{prefix}
+	}
+
+	end := time.Now().UTC()
+	if endTime != nil {
+		end = endTime.UTC()
+	}
+
+	prefixes := []string{}
+	day := startTime.UTC().Truncate(24 * time.Hour)
+	for !day.After(end) {
+		prefixes = append(prefixes, prefix+day.Format(layout))
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return prefixes
+}
+
+// streamS3LogObjectLines reads every object under the prefix line by line,
+// transparently decompressing gzip and zstd objects. Reading stops as soon as
+// fn returns false.
+func streamS3LogObjectLines(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string, prefix string, fn func(key string, line string) bool) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range output.Contents {
+			more, err := readS3LogObjectLines(ctx, svc, bucketName, *object.Key, fn)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func readS3LogObjectLines(ctx context.Context, svc *s3.Client, bucketName string, key string, fn func(key string, line string) bool) (bool, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer object.Body.Close()
+
+	reader, err := newS3ObjectContentReader(object.Body)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !fn(key, line) {
+			return false, nil
+		}
+	}
+
+	return true, scanner.Err()
+}
+
+// newS3ObjectContentReader returns a reader over the content of an object,
+// transparently decompressing gzip and zstd content detected from its magic
+// number.
+func newS3ObjectContentReader(body io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(body)
+	magic, err := buffered.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return gzip.NewReader(buffered)
+	case bytes.HasPrefix(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		zr, err := zstd.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	}
+
+	return io.NopCloser(buffered), nil
+}
+
+// s3ObjectRangeReadSize is the default minimum number of bytes read by each
+// ranged GetObject request of an s3ObjectRangeReader.
+const s3ObjectRangeReadSize = 8 * 1024 * 1024
+
+// s3ObjectRangeReader reads an object at random offsets with ranged GetObject
+// requests, e.g. for columnar formats whose footer is read before the data.
+// Each request reads ahead, so the small sequential reads of a stream are
+// served from the buffer. It is not safe for concurrent use.
+type s3ObjectRangeReader struct {
+	ctx    context.Context
+	svc    *s3.Client
+	bucket string
+	key    string
+	size   int64
+
+	// readSize is the minimum number of bytes read by each request, defaults
+	// to s3ObjectRangeReadSize
+	readSize int64
+
+	// buf holds the bytes of the object read from offset
+	buf    []byte
+	offset int64
+}
+
+func (r *s3ObjectRangeReader) Size() int64 {
+	return r.size
+}
+
+func (r *s3ObjectRangeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	readSize := r.readSize
+	if readSize == 0 {
+		readSize = s3ObjectRangeReadSize
+	}
+	end := min(off+int64(len(p)), r.size)
+	if off < r.offset || end > r.offset+int64(len(r.buf)) {
+		if err := r.fill(off, off+max(int64(len(p)), readSize)); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf[off-r.offset:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// fill reads the bytes of the object from off to end into the buffer with a
+// single request, unless they are buffered already.
+func (r *s3ObjectRangeReader) fill(off int64, end int64) error {
+	end = min(end, r.size)
+	if off >= r.offset && end <= r.offset+int64(len(r.buf)) {
+		return nil
+	}
+
+	object, err := r.svc.GetObject(r.ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, end-1)),
+	})
+	if err != nil {
+		return err
+	}
+	defer object.Body.Close()
+
+	buf, err := io.ReadAll(object.Body)
+	if err != nil {
+		return err
+	}
+	r.buf, r.offset = buf, off
+	return nil
+}
@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/parquet-go/parquet-go"
+	parquetformat "github.com/parquet-go/parquet-go/format"
+)
+
+// s3ParquetFooterReadSize is the number of bytes read by the requests for the
+// header and footer of a Parquet file. The column chunks of each row group are
+// read with a request of their own.
+const s3ParquetFooterReadSize = 64 * 1024
+
+// parquetJulianDayOfUnixEpoch is the Julian day of 1970-01-01, used to decode
+// legacy INT96 timestamps.
+const parquetJulianDayOfUnixEpoch = 2440588
+
+// readS3ParquetFile reads a Parquet data file with ranged requests. Each row
+// group is read with a single request before its rows are decoded, so only one
+// row group of the file is held in memory at a time.
+func readS3ParquetFile(ctx context.Context, svc *s3.Client, bucketName string, key string, size int64, fn func(fields map[string]interface{}) bool) (bool, error) {
+	file := &s3ObjectRangeReader{
+		ctx:      ctx,
+		svc:      svc,
+		bucket:   bucketName,
+		key:      key,
+		size:     size,
+		readSize: s3ParquetFooterReadSize,
+	}
+	return readParquetRows(file, size, file.fill, fn)
+}
+
+// readParquetRows reads the rows of a Parquet file, whose values are keyed by
+// the names of the top level columns of the file schema. If set, prefetch is
+// called with the byte range of each row group before it is read.
+func readParquetRows(file io.ReaderAt, size int64, prefetch func(off int64, end int64) error, fn func(fields map[string]interface{}) bool) (bool, error) {
+	f, err := parquet.OpenFile(file, size, parquet.SkipPageIndex(true), parquet.SkipBloomFilters(true))
+	if err != nil {
+		return false, err
+	}
+
+	schema := f.Schema()
+	columns := parquetColumns(schema)
+	rows := make([]parquet.Row, 128)
+	for i, rowGroup := range f.RowGroups() {
+		if prefetch != nil {
+			off, end := parquetRowGroupRange(&f.Metadata().RowGroups[i])
+			if err := prefetch(off, end); err != nil {
+				return false, err
+			}
+		}
+
+		more, err := readParquetRowGroup(rowGroup, columns, rows, fn)
+		if err != nil || !more {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func readParquetRowGroup(rowGroup parquet.RowGroup, columns []parquetColumn, rows []parquet.Row, fn func(fields map[string]interface{}) bool) (bool, error) {
+	reader := rowGroup.Rows()
+	defer reader.Close()
+
+	for {
+		n, err := reader.ReadRows(rows)
+		for _, row := range rows[:n] {
+			if !fn(parquetFields(columns, row)) {
+				return false, nil
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// parquetRowGroupRange returns the byte range of the column chunks of a row
+// group, from the first dictionary or data page to the end of the last chunk.
+func parquetRowGroupRange(rowGroup *parquetformat.RowGroup) (int64, int64) {
+	var off, end int64 = math.MaxInt64, 0
+	for _, column := range rowGroup.Columns {
+		start := column.MetaData.DataPageOffset
+		if dictionary := column.MetaData.DictionaryPageOffset; dictionary > 0 && dictionary < start {
+			start = dictionary
+		}
+		off = min(off, start)
+		end = max(end, start+column.MetaData.TotalCompressedSize)
+	}
+	if end == 0 {
+		return 0, 0
+	}
+	return off, end
+}
+
+// parquetColumn is a top level column of a Parquet schema, with the leaf
+// columns it is stored in.
+type parquetColumn struct {
+	name   string
+	leaves []parquet.LeafColumn
+}
+
+func parquetColumns(schema *parquet.Schema) []parquetColumn {
+	var columns []parquetColumn
+	for _, path := range schema.Columns() {
+		leaf, _ := schema.Lookup(path...)
+		if len(columns) == 0 || columns[len(columns)-1].name != path[0] {
+			columns = append(columns, parquetColumn{name: path[0]})
+		}
+		columns[len(columns)-1].leaves = append(columns[len(columns)-1].leaves, leaf)
+	}
+	return columns
+}
+
+// parquetFields returns the non null values of a row. Primitive columns are
+// returned as Go values, see parquetValue. Maps, e.g. the resource_tags column
+// of CUR 2.0, are returned as map[string]interface{}, lists as []interface{}
+// and other groups as map[string]interface{} keyed by the path of each leaf.
+func parquetFields(columns []parquetColumn, row parquet.Row) map[string]interface{} {
+	var values [][]parquet.Value
+	row.Range(func(columnIndex int, columnValues []parquet.Value) bool {
+		for len(values) <= columnIndex {
+			values = append(values, nil)
+		}
+		values[columnIndex] = columnValues
+		return true
+	})
+
+	fields := map[string]interface{}{}
+	for _, column := range columns {
+		var value interface{}
+		switch leaves := column.leaves; {
+		case len(leaves) == 1:
+			value = parquetList(leaves[0], parquetLeafValues(values, leaves[0]))
+		case len(leaves) == 2 && leaves[0].Path[len(leaves[0].Path)-1] == "key" && leaves[1].Path[len(leaves[1].Path)-1] == "value":
+			value = parquetMap(leaves, parquetLeafValues(values, leaves[0]), parquetLeafValues(values, leaves[1]))
+		default:
+			group := map[string]interface{}{}
+			for _, leaf := range leaves {
+				if v := parquetList(leaf, parquetLeafValues(values, leaf)); v != nil {
+					group[strings.Join(leaf.Path[1:], ".")] = v
+				}
+			}
+			if len(group) > 0 {
+				value = group
+			}
+		}
+		if value != nil {
+			fields[column.name] = value
+		}
+	}
+	return fields
+}
+
+func parquetLeafValues(values [][]parquet.Value, leaf parquet.LeafColumn) []parquet.Value {
+	if leaf.ColumnIndex < len(values) {
+		return values[leaf.ColumnIndex]
+	}
+	return nil
+}
+
+// parquetList returns the non null values of a leaf column, or the value of a
+// leaf column that is not repeated.
+func parquetList(leaf parquet.LeafColumn, values []parquet.Value) interface{} {
+	var list []interface{}
+	for _, value := range values {
+		if v := parquetValue(leaf.Node.Type(), value); v != nil {
+			list = append(list, v)
+		}
+	}
+	if list == nil {
+		return nil
+	}
+	if leaf.MaxRepetitionLevel == 0 {
+		return list[0]
+	}
+	return list
+}
+
+// parquetMap returns the entries of a map column with non null values, from
+// the values of its key and value leaf columns.
+func parquetMap(leaves []parquet.LeafColumn, keys []parquet.Value, values []parquet.Value) interface{} {
+	m := map[string]interface{}{}
+	for i, key := range keys {
+		k, ok := parquetValue(leaves[0].Node.Type(), key).(string)
+		if !ok || i >= len(values) {
+			continue
+		}
+		if v := parquetValue(leaves[1].Node.Type(), values[i]); v != nil {
+			m[k] = v
+		}
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// parquetValue converts the value of a primitive column to a Go value by its
+// logical type: strings, int64, float64 (also for decimals), bool and UTC
+// time.Time for dates and timestamps. It returns nil for null values.
+func parquetValue(t parquet.Type, v parquet.Value) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+
+	logicalType := t.LogicalType()
+	if logicalType == nil {
+		logicalType = &parquetformat.LogicalType{}
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32:
+		switch {
+		case logicalType.Date != nil:
+			return time.Unix(int64(v.Int32())*24*60*60, 0).UTC()
+		case logicalType.Decimal != nil:
+			return float64(v.Int32()) / math.Pow10(int(logicalType.Decimal.Scale))
+		}
+		return int64(v.Int32())
+	case parquet.Int64:
+		switch {
+		case logicalType.Timestamp != nil && logicalType.Timestamp.Unit.Millis != nil:
+			return time.UnixMilli(v.Int64()).UTC()
+		case logicalType.Timestamp != nil && logicalType.Timestamp.Unit.Micros != nil:
+			return time.UnixMicro(v.Int64()).UTC()
+		case logicalType.Timestamp != nil:
+			return time.Unix(0, v.Int64()).UTC()
+		case logicalType.Decimal != nil:
+			return float64(v.Int64()) / math.Pow10(int(logicalType.Decimal.Scale))
+		}
+		return v.Int64()
+	case parquet.Int96:
+		// Legacy timestamps, as the nanoseconds of a Julian day
+		i := v.Int96()
+		nanoseconds := int64(i[1])<<32 | int64(i[0])
+		days := int64(i[2]) - parquetJulianDayOfUnixEpoch
+		return time.Unix(days*24*60*60, nanoseconds).UTC()
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		if logicalType.Decimal != nil {
+			return parquetDecimal(v.ByteArray(), logicalType.Decimal.Scale)
+		}
+		return string(v.ByteArray())
+	}
+	return nil
+}
+
+// parquetDecimal converts a decimal stored as a big-endian two's complement
+// unscaled value.
+func parquetDecimal(b []byte, scale int32) float64 {
+	unscaled := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(unscaled), big.NewFloat(math.Pow10(int(scale)))).Float64()
+	return f
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestReadParquetRows(t *testing.T) {
+	type row struct {
+		Key              string            `parquet:"key"`
+		Size             int64             `parquet:"size"`
+		Cost             *float64          `parquet:"cost,optional"`
+		LastModifiedDate time.Time         `parquet:"last_modified_date,timestamp(millisecond)"`
+		IsLatest         bool              `parquet:"is_latest"`
+		ResourceTags     map[string]string `parquet:"resource_tags"`
+	}
+
+	var buf bytes.Buffer
+	w := parquet.NewGenericWriter[row](&buf, parquet.MaxRowsPerRowGroup(2))
+	lastModified := time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)
+	cost := 0.25
+	rows := []row{
+		{Key: "photos/cat.jpg", Size: 1024, LastModifiedDate: lastModified, IsLatest: true},
+		{Key: "photos/dog.jpg", Size: 2048, Cost: &cost, LastModifiedDate: lastModified, ResourceTags: map[string]string{"team": "pets"}},
+		{Key: "photos/owl.jpg", Size: 4096, LastModifiedDate: lastModified},
+	}
+	if _, err := w.Write(rows); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file := bytes.NewReader(buf.Bytes())
+	var ranges [][2]int64
+	prefetch := func(off int64, end int64) error {
+		ranges = append(ranges, [2]int64{off, end})
+		return nil
+	}
+
+	records := []map[string]interface{}{}
+	more, err := readParquetRows(file, file.Size(), prefetch, func(fields map[string]interface{}) bool {
+		records = append(records, fields)
+		return len(records) < 3
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if more {
+		t.Errorf("expected reading to stop once no more rows are needed")
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+	if len(ranges) != 2 || ranges[0][0] != 4 || ranges[0][1] > ranges[1][0] || ranges[1][1] > file.Size() {
+		t.Errorf("unexpected row group ranges %v", ranges)
+	}
+
+	first := records[0]
+	if first["key"] != "photos/cat.jpg" || first["size"] != int64(1024) || first["is_latest"] != true {
+		t.Errorf("unexpected record %v", first)
+	}
+	if first["last_modified_date"] != lastModified {
+		t.Errorf("unexpected last modified date %v", first["last_modified_date"])
+	}
+	if _, ok := first["cost"]; ok {
+		t.Errorf("expected null cost to be omitted, got %v", first["cost"])
+	}
+	if _, ok := first["resource_tags"]; ok {
+		t.Errorf("expected empty resource tags to be omitted, got %v", first["resource_tags"])
+	}
+
+	second := records[1]
+	if second["cost"] != 0.25 {
+		t.Errorf("unexpected cost %v", second["cost"])
+	}
+	if tags, ok := second["resource_tags"].(map[string]interface{}); !ok || len(tags) != 1 || tags["team"] != "pets" {
+		t.Errorf("unexpected resource tags %v", second["resource_tags"])
+	}
+}
@@ -0,0 +1,474 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	s3controlTypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// s3EffectivePublicAccess explains whether a bucket can be accessed by anyone,
+// combining every setting that grants or blocks public access to it.
+type s3EffectivePublicAccess struct {
+	IsPublic                 bool
+	BlockPublicAccess        s3BlockPublicAccess
+	AccountBlockPublicAccess s3BlockPublicAccess
+	BucketBlockPublicAccess  s3BlockPublicAccess
+	AclsDisabled             bool
+	PublicAclGrants          []string
+	PublicPolicyStatements   []string
+	AccessPoints             []s3AccessPointPublicAccess
+	MultiRegionAccessPoints  []s3AccessPointPublicAccess
+	Reasons                  []string
+}
+
+type s3BlockPublicAccess struct {
+	BlockPublicAcls       bool
+	BlockPublicPolicy     bool
+	IgnorePublicAcls      bool
+	RestrictPublicBuckets bool
+}
+
+type s3AccessPointPublicAccess struct {
+	Name                   string
+	NetworkOrigin          string
+	IsPublic               bool
+	BlockPublicAccess      s3BlockPublicAccess
+	PublicPolicyStatements []string
+}
+
+// s3PublicAccessInput holds the settings of a bucket and its account that
+// affect public access.
+type s3PublicAccessInput struct {
+	AccountBlockPublicAccess s3BlockPublicAccess
+	BucketBlockPublicAccess  s3BlockPublicAccess
+	ObjectOwnership          string
+	AclGrants                []types.Grant
+	Policy                   string
+	AccessPoints             []s3AccessPointPolicy
+	MultiRegionAccessPoints  []s3AccessPointPolicy
+}
+
+type s3AccessPointPolicy struct {
+	Name              string
+	NetworkOrigin     string
+	BlockPublicAccess s3BlockPublicAccess
+	Policy            string
+}
+
+type s3MultiRegionAccessPointPolicy struct {
+	s3AccessPointPolicy
+	Buckets []string
+}
+
+var s3PublicAclGroups = map[string]string{
+	"http://acs.amazonaws.com/groups/global/AllUsers":           "AllUsers",
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers": "AuthenticatedUsers",
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBucketEffectivePublicAccess(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	if h.HydrateResults["getBucketLocation"] == nil {
+		return nil, nil
+	}
+
+	bucket := h.Item.(types.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	input := s3PublicAccessInput{}
+
+	accountAccessBlock, err := getS3AccountPublicAccessBlock(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketEffectivePublicAccess", "account_public_access_block_error", err)
+		return nil, err
+	}
+	input.AccountBlockPublicAccess = s3ControlBlockPublicAccess(accountAccessBlock.(*s3controlTypes.PublicAccessBlockConfiguration))
+
+	if accessBlock, ok := h.HydrateResults["getBucketPublicAccessBlock"].(*types.PublicAccessBlockConfiguration); ok && accessBlock != nil {
+		input.BucketBlockPublicAccess = s3BlockPublicAccess{
+			BlockPublicAcls:       accessBlock.BlockPublicAcls,
+			BlockPublicPolicy:     accessBlock.BlockPublicPolicy,
+			IgnorePublicAcls:      accessBlock.IgnorePublicAcls,
+			RestrictPublicBuckets: accessBlock.RestrictPublicBuckets,
+		}
+	}
+	if ownership, ok := h.HydrateResults["getS3BucketObjectOwnershipControl"].(*types.OwnershipControls); ok && ownership != nil {
+		for _, rule := range ownership.Rules {
+			input.ObjectOwnership = string(rule.ObjectOwnership)
+		}
+	}
+	if acl, ok := h.HydrateResults["getBucketACL"].(*map[string]any); ok && acl != nil {
+		input.AclGrants, _ = (*acl)["Grants"].([]types.Grant)
+	}
+	if policy, ok := h.HydrateResults["getBucketPolicy"].(*s3.GetBucketPolicyOutput); ok && policy != nil {
+		input.Policy = aws.ToString(policy.Policy)
+	}
+
+	input.AccessPoints, err = listS3BucketAccessPointPolicies(ctx, d, h, *bucket.Name, string(location.LocationConstraint))
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketEffectivePublicAccess", "access_point_error", err)
+		return nil, err
+	}
+
+	multiRegionAccessPoints, err := listS3MultiRegionAccessPointPolicies(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket.getBucketEffectivePublicAccess", "multi_region_access_point_error", err)
+		return nil, err
+	}
+	for _, accessPoint := range multiRegionAccessPoints.([]s3MultiRegionAccessPointPolicy) {
+		for _, name := range accessPoint.Buckets {
+			if name == *bucket.Name {
+				input.MultiRegionAccessPoints = append(input.MultiRegionAccessPoints, accessPoint.s3AccessPointPolicy)
+			}
+		}
+	}
+
+	return evaluateS3EffectivePublicAccess(input)
+}
+
+// The account settings are the same for every bucket, so only fetch them once
+// per connection.
+var getS3AccountPublicAccessBlock = plugin.HydrateFunc(getS3AccountPublicAccessBlockUncached).Memoize()
+
+func getS3AccountPublicAccessBlockUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	clientRegion, err := getDefaultRegion(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := S3ControlClient(ctx, d, clientRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	accessBlock, err := svc.GetPublicAccessBlock(ctx, &s3control.GetPublicAccessBlockInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+	})
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NoSuchPublicAccessBlockConfiguration" {
+				return &s3controlTypes.PublicAccessBlockConfiguration{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return accessBlock.PublicAccessBlockConfiguration, nil
+}
+
+// listS3BucketAccessPointPolicies returns the settings and policies of the
+// access points attached to a bucket.
+func listS3BucketAccessPointPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, bucketName string, region string) ([]s3AccessPointPolicy, error) {
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	accessPoints := []s3AccessPointPolicy{}
+	paginator := s3control.NewListAccessPointsPaginator(svc, &s3control.ListAccessPointsInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Bucket:    aws.String(bucketName),
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range output.AccessPointList {
+			accessPoint := s3AccessPointPolicy{
+				Name:          *item.Name,
+				NetworkOrigin: string(item.NetworkOrigin),
+			}
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			detail, err := svc.GetAccessPoint(ctx, &s3control.GetAccessPointInput{
+				AccountId: aws.String(commonColumnData.AccountId),
+				Name:      item.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+			accessPoint.BlockPublicAccess = s3ControlBlockPublicAccess(detail.PublicAccessBlockConfiguration)
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			policy, err := svc.GetAccessPointPolicy(ctx, &s3control.GetAccessPointPolicyInput{
+				AccountId: aws.String(commonColumnData.AccountId),
+				Name:      item.Name,
+			})
+			if err != nil {
+				var ae smithy.APIError
+				if !errors.As(err, &ae) || ae.ErrorCode() != "NoSuchAccessPointPolicy" {
+					return nil, err
+				}
+			} else {
+				accessPoint.Policy = aws.ToString(policy.Policy)
+			}
+
+			accessPoints = append(accessPoints, accessPoint)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+// Multi-Region Access Points are listed for the whole account, so only fetch
+// them once per connection.
+var listS3MultiRegionAccessPointPolicies = plugin.HydrateFunc(listS3MultiRegionAccessPointPoliciesUncached).Memoize()
+
+func listS3MultiRegionAccessPointPoliciesUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accessPoints := []s3MultiRegionAccessPointPolicy{}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Multi-Region Access Points are managed from us-west-2, which is only
+	// available in the commercial partition
+	if commonColumnData.Partition != "aws" {
+		return accessPoints, nil
+	}
+
+	svc, err := S3ControlMultiRegionAccessClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := s3control.NewListMultiRegionAccessPointsPaginator(svc, &s3control.ListMultiRegionAccessPointsInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range output.AccessPoints {
+			accessPoint := s3MultiRegionAccessPointPolicy{
+				s3AccessPointPolicy: s3AccessPointPolicy{
+					Name:              *item.Name,
+					BlockPublicAccess: s3ControlBlockPublicAccess(item.PublicAccessBlock),
+				},
+			}
+			for _, region := range item.Regions {
+				accessPoint.Buckets = append(accessPoint.Buckets, aws.ToString(region.Bucket))
+			}
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			policy, err := svc.GetMultiRegionAccessPointPolicy(ctx, &s3control.GetMultiRegionAccessPointPolicyInput{
+				AccountId: aws.String(commonColumnData.AccountId),
+				Name:      item.Name,
+			})
+			if err != nil {
+				var ae smithy.APIError
+				if !errors.As(err, &ae) || ae.ErrorCode() != "NoSuchMultiRegionAccessPointPolicy" {
+					return nil, err
+				}
+			} else if policy.Policy != nil && policy.Policy.Established != nil {
+				accessPoint.Policy = aws.ToString(policy.Policy.Established.Policy)
+			}
+
+			accessPoints = append(accessPoints, accessPoint)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// evaluateS3EffectivePublicAccess decides whether a bucket is public, recording
+// the reason each setting does or doesn't make it public.
+//
+// Account and bucket Block Public Access settings are combined, as S3 applies
+// the most restrictive of the two. BlockPublicAcls and BlockPublicPolicy only
+// prevent new public ACLs and policies from being added, so existing ones are
+// only neutralized by IgnorePublicAcls and RestrictPublicBuckets respectively.
+func evaluateS3EffectivePublicAccess(input s3PublicAccessInput) (*s3EffectivePublicAccess, error) {
+	account := input.AccountBlockPublicAccess
+	bucket := input.BucketBlockPublicAccess
+	effective := s3BlockPublicAccess{
+		BlockPublicAcls:       account.BlockPublicAcls || bucket.BlockPublicAcls,
+		BlockPublicPolicy:     account.BlockPublicPolicy || bucket.BlockPublicPolicy,
+		IgnorePublicAcls:      account.IgnorePublicAcls || bucket.IgnorePublicAcls,
+		RestrictPublicBuckets: account.RestrictPublicBuckets || bucket.RestrictPublicBuckets,
+	}
+
+	result := &s3EffectivePublicAccess{
+		BlockPublicAccess:        effective,
+		AccountBlockPublicAccess: account,
+		BucketBlockPublicAccess:  bucket,
+		AclsDisabled:             input.ObjectOwnership == string(types.ObjectOwnershipBucketOwnerEnforced),
+		PublicAclGrants:          []string{},
+		PublicPolicyStatements:   []string{},
+		AccessPoints:             []s3AccessPointPublicAccess{},
+		MultiRegionAccessPoints:  []s3AccessPointPublicAccess{},
+		Reasons:                  []string{},
+	}
+
+	// Bucket ACL
+	for _, grant := range input.AclGrants {
+		if grant.Grantee == nil || grant.Grantee.URI == nil {
+			continue
+		}
+		if group, ok := s3PublicAclGroups[*grant.Grantee.URI]; ok {
+			result.PublicAclGrants = append(result.PublicAclGrants, group+":"+string(grant.Permission))
+		}
+	}
+	if len(result.PublicAclGrants) > 0 {
+		grants := strings.Join(result.PublicAclGrants, ", ")
+		switch {
+		case result.AclsDisabled:
+			result.Reasons = append(result.Reasons, fmt.Sprintf("Bucket ACL grants %s, but ACLs are disabled by the BucketOwnerEnforced object ownership setting.", grants))
+		case effective.IgnorePublicAcls:
+			result.Reasons = append(result.Reasons, fmt.Sprintf("Bucket ACL grants %s, but public ACLs are ignored by IgnorePublicAcls at the %s level.", grants, blockPublicAccessLevel(account.IgnorePublicAcls, bucket.IgnorePublicAcls)))
+		default:
+			result.IsPublic = true
+			result.Reasons = append(result.Reasons, fmt.Sprintf("Bucket ACL grants %s.", grants))
+		}
+	}
+
+	// Bucket policy
+	statements, err := policyPublicStatements(input.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) > 0 {
+		result.PublicPolicyStatements = statements
+		list := strings.Join(statements, ", ")
+		if effective.RestrictPublicBuckets {
+			result.Reasons = append(result.Reasons, fmt.Sprintf("Bucket policy statements %s allow public access, but access is restricted to the bucket owner account and AWS services by RestrictPublicBuckets at the %s level.", list, blockPublicAccessLevel(account.RestrictPublicBuckets, bucket.RestrictPublicBuckets)))
+		} else {
+			result.IsPublic = true
+			result.Reasons = append(result.Reasons, fmt.Sprintf("Bucket policy statements %s allow public access.", list))
+		}
+	}
+
+	// Access points and Multi-Region Access Points
+	for _, accessPoint := range input.AccessPoints {
+		evaluated, err := evaluateS3AccessPointPublicAccess(accessPoint, effective)
+		if err != nil {
+			return nil, err
+		}
+		result.AccessPoints = append(result.AccessPoints, *evaluated)
+		result.IsPublic = result.IsPublic || evaluated.IsPublic
+		result.Reasons = append(result.Reasons, s3AccessPointReason(accessPoint, "Access point", evaluated, effective)...)
+	}
+	for _, accessPoint := range input.MultiRegionAccessPoints {
+		evaluated, err := evaluateS3AccessPointPublicAccess(accessPoint, effective)
+		if err != nil {
+			return nil, err
+		}
+		result.MultiRegionAccessPoints = append(result.MultiRegionAccessPoints, *evaluated)
+		result.IsPublic = result.IsPublic || evaluated.IsPublic
+		result.Reasons = append(result.Reasons, s3AccessPointReason(accessPoint, "Multi-Region Access Point", evaluated, effective)...)
+	}
+
+	if len(result.Reasons) == 0 {
+		result.Reasons = append(result.Reasons, "No bucket ACL grant, bucket policy statement or access point policy allows public access.")
+	}
+
+	return result, nil
+}
+
+// evaluateS3AccessPointPublicAccess decides whether an access point makes the
+// bucket public. Its own Block Public Access settings apply on top of the
+// account and bucket settings, and an access point restricted to a VPC can't
+// be reached from the internet.
+func evaluateS3AccessPointPublicAccess(accessPoint s3AccessPointPolicy, effective s3BlockPublicAccess) (*s3AccessPointPublicAccess, error) {
+	statements, err := policyPublicStatements(accessPoint.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if statements == nil {
+		statements = []string{}
+	}
+
+	return &s3AccessPointPublicAccess{
+		Name:                   accessPoint.Name,
+		NetworkOrigin:          accessPoint.NetworkOrigin,
+		BlockPublicAccess:      accessPoint.BlockPublicAccess,
+		PublicPolicyStatements: statements,
+		IsPublic: len(statements) > 0 &&
+			accessPoint.NetworkOrigin != string(s3controlTypes.NetworkOriginVpc) &&
+			!accessPoint.BlockPublicAccess.RestrictPublicBuckets &&
+			!effective.RestrictPublicBuckets,
+	}, nil
+}
+
+func s3AccessPointReason(accessPoint s3AccessPointPolicy, kind string, evaluated *s3AccessPointPublicAccess, effective s3BlockPublicAccess) []string {
+	if len(evaluated.PublicPolicyStatements) == 0 {
+		return nil
+	}
+
+	prefix := fmt.Sprintf("%s %s policy statements %s allow public access", kind, accessPoint.Name, strings.Join(evaluated.PublicPolicyStatements, ", "))
+	switch {
+	case accessPoint.NetworkOrigin == string(s3controlTypes.NetworkOriginVpc):
+		return []string{prefix + ", but the access point only accepts requests from a VPC."}
+	case accessPoint.BlockPublicAccess.RestrictPublicBuckets:
+		return []string{prefix + ", but access is restricted by RestrictPublicBuckets on the access point."}
+	case effective.RestrictPublicBuckets:
+		return []string{prefix + ", but access is restricted by RestrictPublicBuckets on the account or bucket."}
+	}
+	return []string{prefix + "."}
+}
+
+func blockPublicAccessLevel(account bool, bucket bool) string {
+	switch {
+	case account && bucket:
+		return "account and bucket"
+	case account:
+		return "account"
+	}
+	return "bucket"
+}
+
+func s3ControlBlockPublicAccess(accessBlock *s3controlTypes.PublicAccessBlockConfiguration) s3BlockPublicAccess {
+	if accessBlock == nil {
+		return s3BlockPublicAccess{}
+	}
+	return s3BlockPublicAccess{
+		BlockPublicAcls:       aws.ToBool(accessBlock.BlockPublicAcls),
+		BlockPublicPolicy:     aws.ToBool(accessBlock.BlockPublicPolicy),
+		IgnorePublicAcls:      aws.ToBool(accessBlock.IgnorePublicAcls),
+		RestrictPublicBuckets: aws.ToBool(accessBlock.RestrictPublicBuckets),
+	}
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const testPublicReadPolicy = `{"Version": "2012-10-17", "Statement": [{"Sid": "PublicRead", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*"}]}`
+
+func TestEvaluateS3EffectivePublicAccess(t *testing.T) {
+	publicAclGrants := []types.Grant{{
+		Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/global/AllUsers")},
+		Permission: types.PermissionRead,
+	}}
+
+	testCases := []struct {
+		name     string
+		input    s3PublicAccessInput
+		expected bool
+	}{
+		{"private", s3PublicAccessInput{}, false},
+		{"public acl", s3PublicAccessInput{AclGrants: publicAclGrants}, true},
+		{"public acl with acls disabled", s3PublicAccessInput{AclGrants: publicAclGrants, ObjectOwnership: "BucketOwnerEnforced"}, false},
+		{"public acl ignored by account", s3PublicAccessInput{AclGrants: publicAclGrants, AccountBlockPublicAccess: s3BlockPublicAccess{IgnorePublicAcls: true}}, false},
+		{"public acl with only block public acls", s3PublicAccessInput{AclGrants: publicAclGrants, BucketBlockPublicAccess: s3BlockPublicAccess{BlockPublicAcls: true}}, true},
+		{"public policy", s3PublicAccessInput{Policy: testPublicReadPolicy}, true},
+		{"public policy restricted by bucket", s3PublicAccessInput{Policy: testPublicReadPolicy, BucketBlockPublicAccess: s3BlockPublicAccess{RestrictPublicBuckets: true}}, false},
+		{"public policy with only block public policy", s3PublicAccessInput{Policy: testPublicReadPolicy, AccountBlockPublicAccess: s3BlockPublicAccess{BlockPublicPolicy: true}}, true},
+		{"public access point", s3PublicAccessInput{AccessPoints: []s3AccessPointPolicy{{Name: "ap", NetworkOrigin: "Internet", Policy: testPublicReadPolicy}}}, true},
+		{"public vpc access point", s3PublicAccessInput{AccessPoints: []s3AccessPointPolicy{{Name: "ap", NetworkOrigin: "VPC", Policy: testPublicReadPolicy}}}, false},
+		{"public access point restricted by access point", s3PublicAccessInput{AccessPoints: []s3AccessPointPolicy{{Name: "ap", NetworkOrigin: "Internet", Policy: testPublicReadPolicy, BlockPublicAccess: s3BlockPublicAccess{RestrictPublicBuckets: true}}}}, false},
+		{"public multi-region access point", s3PublicAccessInput{MultiRegionAccessPoints: []s3AccessPointPolicy{{Name: "mrap", Policy: testPublicReadPolicy}}}, true},
+	}
+
+	for _, c := range testCases {
+		result, err := evaluateS3EffectivePublicAccess(c.input)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if result.IsPublic != c.expected {
+			t.Errorf("%s: expected is_public %t, got %t with reasons %v", c.name, c.expected, result.IsPublic, result.Reasons)
+		}
+		if len(result.Reasons) == 0 {
+			t.Errorf("%s: expected a reason", c.name)
+		}
+	}
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -46,7 +48,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go-v2/service/dax"
@@ -113,6 +117,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake"
@@ -156,6 +161,7 @@ import (
 	codepipelineEndpoint "github.com/aws/aws-sdk-go/service/codepipeline"
 	cognitoidentityEndpoint "github.com/aws/aws-sdk-go/service/cognitoidentity"
 	cognitoidentityproviderEndpoint "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	computeoptimizerEndpoint "github.com/aws/aws-sdk-go/service/computeoptimizer"
 	daxEndpoint "github.com/aws/aws-sdk-go/service/dax"
 	directoryserviceEndpoint "github.com/aws/aws-sdk-go/service/directoryservice"
 	dlmEndpoint "github.com/aws/aws-sdk-go/service/dlm"
@@ -365,6 +371,31 @@ func BackupClient(ctx context.Context, d *plugin.QueryData) (*backup.Client, err
 	return backup.NewFromConfig(*cfg), nil
 }
 
+func BcmDataExportsClient(ctx context.Context, d *plugin.QueryData) (*bcmdataexports.Client, error) {
+	// The Data Exports API is only available in us-east-1
+	// (bcm-data-exports.us-east-1.amazonaws.com), so there is no client for
+	// the other partitions.
+	// https://docs.aws.amazon.com/general/latest/gr/billing.html
+	cfg, err := getClientForLastResortRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Region != "us-east-1" {
+		return nil, nil
+	}
+	return bcmdataexports.NewFromConfig(*cfg), nil
+}
+
+func BudgetsClient(ctx context.Context, d *plugin.QueryData) (*budgets.Client, error) {
+	// AWS Budgets is a global service (budgets.amazonaws.com), signed for the
+	// last resort region of the partition.
+	cfg, err := getClientForLastResortRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return budgets.NewFromConfig(*cfg), nil
+}
+
 func CloudControlClient(ctx context.Context, d *plugin.QueryData) (*cloudcontrol.Client, error) {
 	// CloudControl returns GeneralServiceException in a lot of situations, which
 	// AWS SDK treats as retryable. This is frustrating because we end up retrying
@@ -530,6 +561,17 @@ func ConfigClient(ctx context.Context, d *plugin.QueryData) (*configservice.Clie
 	return configservice.NewFromConfig(*cfg), nil
 }
 
+func ComputeOptimizerClient(ctx context.Context, d *plugin.QueryData) (*computeoptimizer.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, computeoptimizerEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return computeoptimizer.NewFromConfig(*cfg), nil
+}
+
 func CostExplorerClient(ctx context.Context, d *plugin.QueryData) (*costexplorer.Client, error) {
 	// Cost Explorer is a global service that operates from a single
 	// region (ce.us-east-1.amazonaws.com).
@@ -543,6 +585,18 @@ func CostExplorerClient(ctx context.Context, d *plugin.QueryData) (*costexplorer
 	return costexplorer.NewFromConfig(*cfg), nil
 }
 
+func CostAndUsageReportClient(ctx context.Context, d *plugin.QueryData) (*costandusagereportservice.Client, error) {
+	// The Cost and Usage Report API is only available from the last resort
+	// region of the partition (cur.us-east-1.amazonaws.com, or
+	// cur.cn-northwest-1.amazonaws.com.cn).
+	// https://docs.aws.amazon.com/general/latest/gr/billing.html
+	cfg, err := getClientForLastResortRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return costandusagereportservice.NewFromConfig(*cfg), nil
+}
+
 func DatabaseMigrationClient(ctx context.Context, d *plugin.QueryData) (*databasemigrationservice.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -1312,6 +1366,16 @@ func SageMakerClient(ctx context.Context, d *plugin.QueryData) (*sagemaker.Clien
 	return sagemaker.NewFromConfig(*cfg), nil
 }
 
+func SavingsPlansClient(ctx context.Context, d *plugin.QueryData) (*savingsplans.Client, error) {
+	// Savings Plans is a global service (savingsplans.amazonaws.com), signed
+	// for the last resort region of the partition.
+	cfg, err := getClientForLastResortRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return savingsplans.NewFromConfig(*cfg), nil
+}
+
 func SecretsManagerClient(ctx context.Context, d *plugin.QueryData) (*secretsmanager.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBudgetsBudget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_budgets_budget",
+		Description: "AWS Budgets Budget",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getBudgetsBudget,
+			Tags:       map[string]string{"service": "budgets", "action": "DescribeBudget"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBudgetsBudgets,
+			Tags:    map[string]string{"service": "budgets", "action": "DescribeBudgets"},
+			// Accounts without any budgets return a NotFoundException
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBudgetsBudgetArn,
+				Tags: map[string]string{"service": "sts", "action": "GetCallerIdentity"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the budget.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BudgetName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the budget.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBudgetsBudgetArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "budget_type",
+				Description: "Specifies whether this budget tracks costs, usage, RI utilization, RI coverage, Savings Plans utilization, or Savings Plans coverage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_unit",
+				Description: "The length of time until a budget resets the actual and forecasted spend. Possible values are: DAILY, MONTHLY, QUARTERLY, ANNUALLY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "budget_limit_amount",
+				Description: "The total amount of cost, usage, RI utilization, RI coverage, Savings Plans utilization, or Savings Plans coverage that you want to track with your budget.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("BudgetLimit.Amount"),
+			},
+			{
+				Name:        "budget_limit_unit",
+				Description: "The unit of measurement that's used for the budget limit, such as USD or GBP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BudgetLimit.Unit"),
+			},
+			{
+				Name:        "actual_spend_amount",
+				Description: "The amount of cost, usage, RI units, or Savings Plans units that you used in the current budget period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CalculatedSpend.ActualSpend.Amount"),
+			},
+			{
+				Name:        "actual_spend_unit",
+				Description: "The unit of measurement that's used for the actual spend.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CalculatedSpend.ActualSpend.Unit"),
+			},
+			{
+				Name:        "forecasted_spend_amount",
+				Description: "The amount of cost, usage, RI units, or Savings Plans units that you're forecasted to use in the current budget period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CalculatedSpend.ForecastedSpend.Amount"),
+			},
+			{
+				Name:        "forecasted_spend_unit",
+				Description: "The unit of measurement that's used for the forecasted spend.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CalculatedSpend.ForecastedSpend.Unit"),
+			},
+			{
+				Name:        "time_period_start",
+				Description: "The start date for a budget.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.Start"),
+			},
+			{
+				Name:        "time_period_end",
+				Description: "The end date for a budget.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.End"),
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that you updated this budget.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cost_filters",
+				Description: "The cost filters, such as Region, Service, member account, Tag, or Cost Category, that are applied to a budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cost_types",
+				Description: "The types of costs, such as refunds, credits and support fees, that are included in this COST budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "planned_budget_limits",
+				Description: "A map containing multiple budget limits, keyed by the start time of each budget period.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "auto_adjust_data",
+				Description: "The parameters that determine the budget amount for an auto-adjusting budget.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BudgetName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsBudgetArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBudgetsBudgets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.listBudgetsBudgets", "client_error", err)
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.listBudgetsBudgets", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &budgets.DescribeBudgetsInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := budgets.NewDescribeBudgetsPaginator(svc, input, func(o *budgets.DescribeBudgetsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_budget.listBudgetsBudgets", "api_error", err)
+			return nil, err
+		}
+
+		for _, budget := range output.Budgets {
+			d.StreamListItem(ctx, budget)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBudgetsBudget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.getBudgetsBudget", "client_error", err)
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.getBudgetsBudget", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	output, err := svc.DescribeBudget(ctx, &budgets.DescribeBudgetInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		BudgetName: aws.String(name),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.getBudgetsBudget", "api_error", err)
+		return nil, err
+	}
+
+	return *output.Budget, nil
+}
+
+func getBudgetsBudgetArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	budget := h.Item.(types.Budget)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget.getBudgetsBudgetArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// arn:aws:budgets::123456789012:budget/budget-name
+	return budgetsArn(commonColumnData, "budget/"+aws.ToString(budget.BudgetName)), nil
+}
+
+// budgetsArn builds the ARN of a Budgets resource of the account, since the
+// API doesn't return them.
+func budgetsArn(commonColumnData *awsCommonColumnData, resource string) string {
+	return fmt.Sprintf("arn:%s:budgets::%s:%s", commonColumnData.Partition, commonColumnData.AccountId, resource)
+}
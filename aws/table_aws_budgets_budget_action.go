@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBudgetsBudgetAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_budgets_budget_action",
+		Description: "AWS Budgets Budget Action",
+		List: &plugin.ListConfig{
+			Hydrate: listBudgetsBudgetActions,
+			Tags:    map[string]string{"service": "budgets", "action": "DescribeBudgetActionsForAccount"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "budget_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBudgetsBudgetActionArn,
+				Tags: map[string]string{"service": "sts", "action": "GetCallerIdentity"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "action_id",
+				Description: "A system-generated universally unique identifier (UUID) for the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "budget_name",
+				Description: "The name of the budget the action belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the budget action.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBudgetsBudgetActionArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "action_type",
+				Description: "The type of action. This defines the type of tasks that can be carried out by this action. Possible values are: APPLY_IAM_POLICY, APPLY_SCP_POLICY, RUN_SSM_DOCUMENTS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approval_model",
+				Description: "This specifies if the action needs manual or automatic approval. Possible values are: AUTOMATIC, MANUAL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notification_type",
+				Description: "The type of threshold for a notification. Possible values are: ACTUAL, FORECASTED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action_threshold_value",
+				Description: "The threshold of the notification that triggers the action.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ActionThreshold.ActionThresholdValue"),
+			},
+			{
+				Name:        "action_threshold_type",
+				Description: "The type of threshold for a notification. Possible values are: PERCENTAGE, ABSOLUTE_VALUE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionThreshold.ActionThresholdType"),
+			},
+			{
+				Name:        "execution_role_arn",
+				Description: "The role passed for action execution and reversion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "definition",
+				Description: "Where you specify all of the type-specific parameters of the IAM policy, SCP or SSM document to apply.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subscribers",
+				Description: "A list of subscribers notified when the action runs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsBudgetActionArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBudgetsBudgetActions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget_action.listBudgetsBudgetActions", "client_error", err)
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget_action.listBudgetsBudgetActions", "common_data_error", err)
+		return nil, err
+	}
+	accountId := aws.String(commonData.(*awsCommonColumnData).AccountId)
+
+	streamActions := func(actions []types.Action) bool {
+		for _, action := range actions {
+			d.StreamListItem(ctx, action)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	// List the actions of a single budget if the budget name is provided
+	if budgetName := d.EqualsQualString("budget_name"); budgetName != "" {
+		input := &budgets.DescribeBudgetActionsForBudgetInput{
+			AccountId:  accountId,
+			BudgetName: aws.String(budgetName),
+		}
+		paginator := budgets.NewDescribeBudgetActionsForBudgetPaginator(svc, input, func(o *budgets.DescribeBudgetActionsForBudgetPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_budgets_budget_action.listBudgetsBudgetActions", "api_error", err)
+				return nil, err
+			}
+			if !streamActions(output.Actions) {
+				break
+			}
+		}
+		return nil, nil
+	}
+
+	input := &budgets.DescribeBudgetActionsForAccountInput{
+		AccountId: accountId,
+	}
+	paginator := budgets.NewDescribeBudgetActionsForAccountPaginator(svc, input, func(o *budgets.DescribeBudgetActionsForAccountPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_budget_action.listBudgetsBudgetActions", "api_error", err)
+			return nil, err
+		}
+		if !streamActions(output.Actions) {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBudgetsBudgetActionArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	action := h.Item.(types.Action)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_budget_action.getBudgetsBudgetActionArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// arn:aws:budgets::123456789012:budget/budget-name/action/action-id
+	return budgetsArn(commonColumnData, "budget/"+aws.ToString(action.BudgetName)+"/action/"+aws.ToString(action.ActionId)), nil
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBudgetsNotification(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_budgets_notification",
+		Description: "AWS Budgets Notification",
+		List: &plugin.ListConfig{
+			Hydrate: listBudgetsNotifications,
+			Tags:    map[string]string{"service": "budgets", "action": "DescribeBudgetNotificationsForAccount"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "budget_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBudgetsNotificationSubscribers,
+				Tags: map[string]string{"service": "budgets", "action": "DescribeSubscribersForNotification"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "budget_name",
+				Description: "The name of the budget the notification belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notification_type",
+				Description: "Specifies whether the notification is for how much you have spent (ACTUAL) or for how much that you're forecasted to spend (FORECASTED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comparison_operator",
+				Description: "The comparison that's used for this notification. Possible values are: GREATER_THAN, LESS_THAN, EQUAL_TO.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "threshold",
+				Description: "The threshold that's associated with a notification.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "threshold_type",
+				Description: "The type of threshold for a notification. Possible values are: PERCENTAGE, ABSOLUTE_VALUE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notification_state",
+				Description: "Specifies whether this notification is in alarm. Possible values are: OK, ALARM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subscribers",
+				Description: "A list of subscribers notified by the notification.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsNotificationSubscribers,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type budgetsNotification struct {
+	BudgetName *string
+	*types.Notification
+}
+
+//// LIST FUNCTION
+
+func listBudgetsNotifications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_notification.listBudgetsNotifications", "client_error", err)
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_notification.listBudgetsNotifications", "common_data_error", err)
+		return nil, err
+	}
+	accountId := aws.String(commonData.(*awsCommonColumnData).AccountId)
+
+	streamNotifications := func(budgetName *string, notifications []types.Notification) bool {
+		for i := range notifications {
+			d.StreamListItem(ctx, budgetsNotification{budgetName, &notifications[i]})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	// List the notifications of a single budget if the budget name is provided
+	if budgetName := d.EqualsQualString("budget_name"); budgetName != "" {
+		input := &budgets.DescribeNotificationsForBudgetInput{
+			AccountId:  accountId,
+			BudgetName: aws.String(budgetName),
+		}
+		paginator := budgets.NewDescribeNotificationsForBudgetPaginator(svc, input, func(o *budgets.DescribeNotificationsForBudgetPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_budgets_notification.listBudgetsNotifications", "api_error", err)
+				return nil, err
+			}
+			if !streamNotifications(input.BudgetName, output.Notifications) {
+				break
+			}
+		}
+		return nil, nil
+	}
+
+	input := &budgets.DescribeBudgetNotificationsForAccountInput{
+		AccountId: accountId,
+	}
+	paginator := budgets.NewDescribeBudgetNotificationsForAccountPaginator(svc, input, func(o *budgets.DescribeBudgetNotificationsForAccountPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_notification.listBudgetsNotifications", "api_error", err)
+			return nil, err
+		}
+		for _, item := range output.BudgetNotificationsForAccount {
+			if !streamNotifications(item.BudgetName, item.Notifications) {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBudgetsNotificationSubscribers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	notification := h.Item.(budgetsNotification)
+
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_notification.getBudgetsNotificationSubscribers", "client_error", err)
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_notification.getBudgetsNotificationSubscribers", "common_data_error", err)
+		return nil, err
+	}
+
+	input := &budgets.DescribeSubscribersForNotificationInput{
+		AccountId:    aws.String(commonData.(*awsCommonColumnData).AccountId),
+		BudgetName:   notification.BudgetName,
+		Notification: notification.Notification,
+	}
+
+	var subscribers []types.Subscriber
+	paginator := budgets.NewDescribeSubscribersForNotificationPaginator(svc, input, func(o *budgets.DescribeSubscribersForNotificationPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_notification.getBudgetsNotificationSubscribers", "api_error", err)
+			return nil, err
+		}
+		subscribers = append(subscribers, output.Subscribers...)
+	}
+
+	return subscribers, nil
+}
@@ -0,0 +1,369 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCarbonEmission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_carbon_emission",
+		Description: "AWS customer carbon footprint estimates, read from the carbon emissions data export delivered to S3.",
+		List: &plugin.ListConfig{
+			Hydrate: listCarbonEmissions,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "s3_bucket", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "export_name", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "s3_prefix", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "billing_period", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "export_name",
+				Description: "The name of the data export.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The bucket the data export is delivered to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_prefix",
+				Description: "The prefix of the data export in the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_period",
+				Description: "The start of the billing period (month) the data export was delivered for.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "data_file_key",
+				Description: "The key of the data file the emissions were read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_period_start",
+				Description: "The start of the month of usage of the emissions.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "usage_period_end",
+				Description: "The end of the month of usage of the emissions.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "payer_account_id",
+				Description: "The ID of the account paying the bill, i.e. the management account of the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_account_id",
+				Description: "The ID of the account whose usage the emissions are attributed to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_code",
+				Description: "The code of the service the emissions are attributed to, e.g. AmazonEC2.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region_code",
+				Description: "The region the emissions are attributed to, e.g. us-east-1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The geographic location the emissions are attributed to, if set by the data export.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_mbm_emissions_value",
+				Description: "The estimated emissions, calculated with the market-based method (MBM).",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "total_mbm_emissions_unit",
+				Description: "The unit of the market-based emissions, e.g. MTCO2e (metric tons of CO2-equivalent).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_lbm_emissions_value",
+				Description: "The estimated emissions, calculated with the location-based method (LBM), if set by the data export.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "total_lbm_emissions_unit",
+				Description: "The unit of the location-based emissions, e.g. MTCO2e (metric tons of CO2-equivalent).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "model_version",
+				Description: "The version of the methodology used to estimate the emissions. Estimates made with different versions are not comparable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The time the emissions were last estimated, e.g. after a methodology update.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "fields",
+				Description: "All the fields of the row of the data export, including those not mapped to columns.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProductCode"),
+			},
+		}),
+	}
+}
+
+// carbonEmission is a row of the carbon emissions data export, i.e. the
+// estimated emissions of the usage of a service in a region by an account in a
+// month.
+type carbonEmission struct {
+	ExportName             *string
+	S3Bucket               *string
+	S3Prefix               *string
+	BillingPeriod          *time.Time
+	DataFileKey            *string
+	UsagePeriodStart       *time.Time
+	UsagePeriodEnd         *time.Time
+	PayerAccountId         *string
+	UsageAccountId         *string
+	ProductCode            *string
+	RegionCode             *string
+	Location               *string
+	TotalMbmEmissionsValue *float64
+	TotalMbmEmissionsUnit  *string
+	TotalLbmEmissionsValue *float64
+	TotalLbmEmissionsUnit  *string
+	ModelVersion           *string
+	LastRefreshTimestamp   *time.Time
+	Fields                 map[string]interface{}
+}
+
+//// LIST FUNCTION
+
+func listCarbonEmissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	export := curReport{
+		Name:   d.EqualsQualString("export_name"),
+		Bucket: d.EqualsQualString("s3_bucket"),
+		Prefix: d.EqualsQualString("s3_prefix"),
+	}
+
+	svc, err := s3ClientForBucket(ctx, d, h, export.Bucket)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_carbon_emission.listCarbonEmissions", "client_error", err)
+		return nil, err
+	}
+
+	manifests, err := getCarbonEmissionManifestKeys(ctx, d, svc, export)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_carbon_emission.listCarbonEmissions", "api_error", err)
+		return nil, err
+	}
+
+	periods := make([]time.Time, 0, len(manifests))
+	for period := range manifests {
+		if carbonEmissionPeriodMatches(d.Quals["billing_period"], period) {
+			periods = append(periods, period)
+		}
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Before(periods[j]) })
+
+	for _, period := range periods {
+		more, err := readCarbonEmissionExport(ctx, d, svc, export, period, manifests[period])
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_carbon_emission.listCarbonEmissions", "billing_period", period, "api_error", err)
+			return nil, err
+		}
+		if !more {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getCarbonEmissionManifestKeys returns the key of the latest manifest
+// delivered for each billing period of the export. Like CUR 2.0, the data
+// exports are delivered to:
+// <prefix>/<name>/metadata/BILLING_PERIOD=2023-10/.../<name>-Manifest.json
+func getCarbonEmissionManifestKeys(ctx context.Context, d *plugin.QueryData, svc *s3.Client, export curReport) (map[time.Time]string, error) {
+	latest := map[time.Time]types.Object{}
+	err := listCURObjects(ctx, d, svc, export.Bucket, curReportBasePrefix(export.Prefix, export.Name)+"metadata/", func(object types.Object) {
+		if !strings.HasSuffix(*object.Key, "-Manifest.json") {
+			return
+		}
+		period, ok := carbonEmissionBillingPeriod(*object.Key)
+		if !ok {
+			return
+		}
+		if current, ok := latest[period]; !ok || object.LastModified.After(*current.LastModified) {
+			latest[period] = object
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[time.Time]string, len(latest))
+	for period, object := range latest {
+		keys[period] = *object.Key
+	}
+	return keys, nil
+}
+
+// readCarbonEmissionExport streams the emissions of the data files of the
+// manifest. It returns false once no more rows are needed.
+func readCarbonEmissionExport(ctx context.Context, d *plugin.QueryData, svc *s3.Client, export curReport, period time.Time, manifestKey string) (bool, error) {
+	manifest, err := getCURManifest(ctx, svc, export.Bucket, manifestKey)
+	if err != nil || manifest == nil {
+		return err == nil, err
+	}
+
+	keys := manifest.ReportKeys
+	for _, uri := range manifest.DataFiles {
+		keys = append(keys, strings.TrimPrefix(uri, "s3://"+export.Bucket+"/"))
+	}
+
+	for _, key := range keys {
+		stream := func(fields map[string]interface{}) bool {
+			item := newCarbonEmission(fields)
+			item.ExportName = aws.String(export.Name)
+			item.S3Bucket = aws.String(export.Bucket)
+			item.S3Prefix = aws.String(export.Prefix)
+			item.BillingPeriod = aws.Time(period)
+			item.DataFileKey = aws.String(key)
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.RowsRemaining(ctx) != 0
+		}
+
+		var more bool
+		switch {
+		case strings.HasSuffix(key, ".parquet"):
+			more, err = readCURParquetFile(ctx, svc, export.Bucket, key, stream)
+		case strings.HasSuffix(key, ".csv.gz"), strings.HasSuffix(key, ".csv"):
+			more, err = readCURCsvFile(ctx, svc, export.Bucket, key, stream)
+		default:
+			return false, fmt.Errorf("data export %s data file %s is not supported, only CSV (GZIP compressed) and Parquet exports are supported", export.Name, key)
+		}
+		if err != nil || !more {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// carbonEmissionBillingPeriod returns the billing period of the
+// BILLING_PERIOD=2023-10 folder of the key, if any.
+func carbonEmissionBillingPeriod(key string) (time.Time, bool) {
+	for _, folder := range strings.Split(key, "/") {
+		if value, found := strings.CutPrefix(folder, "BILLING_PERIOD="); found {
+			period, err := time.Parse("2006-01", value)
+			return period, err == nil
+		}
+	}
+	return time.Time{}, false
+}
+
+// carbonEmissionPeriodMatches returns true if the billing period matches all
+// the billing_period quals. The quals are compared with the start of their
+// month, as the billing periods are.
+func carbonEmissionPeriodMatches(quals *plugin.KeyColumnQuals, period time.Time) bool {
+	if quals == nil {
+		return true
+	}
+	for _, q := range quals.Quals {
+		t := q.Value.GetTimestampValue().AsTime().UTC()
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		switch q.Operator {
+		case "=":
+			if !period.Equal(month) {
+				return false
+			}
+		case ">":
+			if !period.After(t) {
+				return false
+			}
+		case ">=":
+			if period.Before(month) {
+				return false
+			}
+		case "<":
+			if !period.Before(t) {
+				return false
+			}
+		case "<=":
+			if period.After(t) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// newCarbonEmission builds a row from the fields of the data export. CSV
+// exports contain only strings, while Parquet exports contain typed values.
+func newCarbonEmission(fields map[string]interface{}) *carbonEmission {
+	return &carbonEmission{
+		UsagePeriodStart:       carbonEmissionTime(fields["usage_period_start"]),
+		UsagePeriodEnd:         carbonEmissionTime(fields["usage_period_end"]),
+		PayerAccountId:         s3InventoryString(fields["payer_account_id"]),
+		UsageAccountId:         s3InventoryString(fields["usage_account_id"]),
+		ProductCode:            s3InventoryString(fields["product_code"]),
+		RegionCode:             s3InventoryString(fields["region_code"]),
+		Location:               s3InventoryString(fields["location"]),
+		TotalMbmEmissionsValue: curFloat64(fields["total_mbm_emissions_value"]),
+		TotalMbmEmissionsUnit:  s3InventoryString(fields["total_mbm_emissions_unit"]),
+		TotalLbmEmissionsValue: curFloat64(fields["total_lbm_emissions_value"]),
+		TotalLbmEmissionsUnit:  s3InventoryString(fields["total_lbm_emissions_unit"]),
+		ModelVersion:           s3InventoryString(fields["model_version"]),
+		LastRefreshTimestamp:   carbonEmissionTime(fields["last_refresh_timestamp"]),
+		Fields:                 fields,
+	}
+}
+
+// carbonEmissionTime parses a timestamp of the data export, which may also be
+// formatted without a time zone, e.g. 2023-10-01 00:00:00, or as a date.
+func carbonEmissionTime(value interface{}) *time.Time {
+	if t := s3InventoryTime(value); t != nil {
+		return t
+	}
+	if v, ok := value.(string); ok {
+		for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return aws.Time(t)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestCarbonEmissionBillingPeriod(t *testing.T) {
+	cases := map[string]string{
+		"exports/carbon/metadata/BILLING_PERIOD=2023-10/carbon-Manifest.json":                  "2023-10-01",
+		"exports/carbon/metadata/BILLING_PERIOD=2024-01/20240215T000000Z/carbon-Manifest.json": "2024-01-01",
+		"exports/carbon/metadata/carbon-Manifest.json":                                         "",
+		"exports/carbon/metadata/BILLING_PERIOD=latest/carbon-Manifest.json":                   "",
+	}
+	for key, expected := range cases {
+		period, ok := carbonEmissionBillingPeriod(key)
+		if expected == "" {
+			if ok {
+				t.Errorf("%s: expected no billing period, got %v", key, period)
+			}
+			continue
+		}
+		if !ok || period.Format("2006-01-02") != expected {
+			t.Errorf("%s: expected %s, got %v", key, expected, period)
+		}
+	}
+}
+
+func TestCarbonEmissionPeriodMatches(t *testing.T) {
+	cases := []struct {
+		name    string
+		quals   *plugin.KeyColumnQuals
+		period  string
+		matches bool
+	}{
+		{"no quals", nil, "2023-10-01T00:00:00Z", true},
+		{"equals mid month", ceTimestampQuals("billing_period", "=", "2023-10-15T00:00:00Z"), "2023-10-01T00:00:00Z", true},
+		{"equals other month", ceTimestampQuals("billing_period", "=", "2023-11-01T00:00:00Z"), "2023-10-01T00:00:00Z", false},
+		{"range", ceTimestampQuals("billing_period", ">=", "2023-01-01T00:00:00Z", "<", "2024-01-01T00:00:00Z"), "2023-12-01T00:00:00Z", true},
+		{"range end", ceTimestampQuals("billing_period", ">=", "2023-01-01T00:00:00Z", "<", "2024-01-01T00:00:00Z"), "2024-01-01T00:00:00Z", false},
+		{"greater than mid month", ceTimestampQuals("billing_period", ">", "2023-10-15T00:00:00Z"), "2023-10-01T00:00:00Z", false},
+	}
+	for _, c := range cases {
+		period, _ := time.Parse(time.RFC3339, c.period)
+		if matches := carbonEmissionPeriodMatches(c.quals, period); matches != c.matches {
+			t.Errorf("%s: expected %v, got %v", c.name, c.matches, matches)
+		}
+	}
+}
+
+func TestNewCarbonEmission(t *testing.T) {
+	item := newCarbonEmission(map[string]interface{}{
+		"usage_period_start":        "2023-10-01 00:00:00",
+		"usage_account_id":          "123456789012",
+		"product_code":              "AmazonEC2",
+		"region_code":               "us-east-1",
+		"total_mbm_emissions_value": "0.125",
+		"total_mbm_emissions_unit":  "MTCO2e",
+		"total_lbm_emissions_value": 0.5,
+		"model_version":             "v3.0.0",
+	})
+	if item.UsagePeriodStart == nil || item.UsagePeriodStart.Format("2006-01-02") != "2023-10-01" {
+		t.Errorf("unexpected usage period start %v", item.UsagePeriodStart)
+	}
+	if item.TotalMbmEmissionsValue == nil || *item.TotalMbmEmissionsValue != 0.125 {
+		t.Errorf("unexpected market-based emissions %v", item.TotalMbmEmissionsValue)
+	}
+	if item.TotalLbmEmissionsValue == nil || *item.TotalLbmEmissionsValue != 0.5 {
+		t.Errorf("unexpected location-based emissions %v", item.TotalLbmEmissionsValue)
+	}
+	if item.ModelVersion == nil || *item.ModelVersion != "v3.0.0" {
+		t.Errorf("unexpected model version %v", item.ModelVersion)
+	}
+}
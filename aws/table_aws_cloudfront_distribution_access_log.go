@@ -0,0 +1,443 @@
+package aws
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontDistributionAccessLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_distribution_access_log",
+		Description: "AWS CloudFront Distribution Access Log",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsCloudFrontDistributions,
+			Hydrate:       listCloudFrontDistributionAccessLogs,
+			Tags:          map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "distribution_id", Require: plugin.Optional},
+				{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "distribution_id",
+				Description: "The identifier of the distribution that served the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time at which the edge server finished responding to the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "edge_location",
+				Description: "The edge location that served the request, identified by a three-letter airport code and an assigned number, e.g. DFW3-C1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bytes_sent",
+				Description: "The total number of bytes that the server sent to the viewer in response to the request, including headers.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "client_ip",
+				Description: "The IP address of the viewer that made the request.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "client_port",
+				Description: "The port number of the request from the viewer.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "method",
+				Description: "The HTTP request method.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host",
+				Description: "The domain name of the CloudFront distribution, e.g. d111111abcdef8.cloudfront.net.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_header",
+				Description: "The value that the viewer included in the Host header of the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "uri_stem",
+				Description: "The portion of the request URL that includes the path, but not the query string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query_string",
+				Description: "The query string portion of the request URL, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "http_status",
+				Description: "The HTTP status code of the response, or 0 if the viewer closed the connection before the server responded.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "referer",
+				Description: "The value of the Referer header in the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_agent",
+				Description: "The value of the User-Agent header in the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cookie",
+				Description: "The Cookie header in the request, if cookie logging is enabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_result_type",
+				Description: "How the server classified the response after the last byte left the server, e.g. Hit, RefreshHit, Miss, LimitExceeded, CapacityExceeded, Error or Redirect.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_response_result_type",
+				Description: "How the server classified the response just before returning it to the viewer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_detailed_result_type",
+				Description: "A more detailed classification of the response, e.g. OriginShieldHit or the type of error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "request_id",
+				Description: "An opaque string that uniquely identifies the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol of the viewer request, e.g. http, https, ws or wss.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protocol_version",
+				Description: "The HTTP version that the viewer specified in the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bytes_received",
+				Description: "The total number of bytes of data that the viewer included in the request, including headers.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "time_taken",
+				Description: "The number of seconds between the server receiving the viewer's request and writing the last byte of the response.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "time_to_first_byte",
+				Description: "The number of seconds between receiving the request and writing the first byte of the response.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "forwarded_for",
+				Description: "The X-Forwarded-For header of the request, if the viewer used an HTTP proxy or a load balancer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tls_version",
+				Description: "The SSL/TLS protocol that the viewer and server negotiated for an HTTPS request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tls_cipher",
+				Description: "The SSL/TLS cipher that the viewer and server negotiated for an HTTPS request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fle_status",
+				Description: "The field-level encryption status of the request, if field-level encryption is configured.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fle_encrypted_fields",
+				Description: "The number of field-level encryption fields that the server encrypted and forwarded to the origin.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "content_type",
+				Description: "The value of the HTTP Content-Type header of the response.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content_length",
+				Description: "The value of the HTTP Content-Length header of the response.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "range_start",
+				Description: "The start value of the Range header of a range request.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "range_end",
+				Description: "The end value of the Range header of a range request.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "log_bucket_name",
+				Description: "The name of the bucket the access log was delivered to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_object_key",
+				Description: "The key of the log object the record was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The raw access log record.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestId"),
+			},
+		}),
+	}
+}
+
+type cloudFrontDistributionAccessLogRecord struct {
+	DistributionId         *string
+	Timestamp              *time.Time
+	EdgeLocation           *string
+	BytesSent              *int64
+	ClientIp               *string
+	ClientPort             *int64
+	Method                 *string
+	Host                   *string
+	HostHeader             *string
+	UriStem                *string
+	QueryString            *string
+	HttpStatus             *int64
+	Referer                *string
+	UserAgent              *string
+	Cookie                 *string
+	EdgeResultType         *string
+	EdgeResponseResultType *string
+	EdgeDetailedResultType *string
+	RequestId              *string
+	Protocol               *string
+	ProtocolVersion        *string
+	BytesReceived          *int64
+	TimeTaken              *float64
+	TimeToFirstByte        *float64
+	ForwardedFor           *string
+	TlsVersion             *string
+	TlsCipher              *string
+	FleStatus              *string
+	FleEncryptedFields     *int64
+	ContentType            *string
+	ContentLength          *int64
+	RangeStart             *int64
+	RangeEnd               *int64
+	LogBucketName          *string
+	LogObjectKey           *string
+	Message                string
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontDistributionAccessLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	distributionId := *h.Item.(types.DistributionSummary).Id
+
+	if d.EqualsQualString("distribution_id") != "" && d.EqualsQualString("distribution_id") != distributionId {
+		return nil, nil
+	}
+
+	config, err := getCloudFrontDistributionConfig(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	logging := config.(*cloudfront.GetDistributionConfigOutput).DistributionConfig.Logging
+	if logging == nil || !aws.ToBool(logging.Enabled) || aws.ToString(logging.Bucket) == "" {
+		return nil, nil
+	}
+
+	// The logging bucket is configured as the bucket's domain name, e.g.
+	// mybucket.s3.amazonaws.com
+	bucketName := *logging.Bucket
+	if i := strings.Index(bucketName, ".s3."); i > 0 {
+		bucketName = bucketName[:i]
+	}
+
+	svc, err := s3ClientForBucket(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_distribution_access_log.listCloudFrontDistributionAccessLogs", "log_bucket_name", bucketName, "client_error", err)
+		return nil, err
+	}
+
+	// Objects are keyed as <prefix><distribution ID>.yyyy-mm-dd-hh.<id>.gz
+	startTime, endTime := timestampQualRange(d.Quals)
+	basePrefix := aws.ToString(logging.Prefix) + distributionId + "."
+
+	for _, objectPrefix := range s3DailyLogPrefixes(basePrefix, "2006-01-02-", startTime, endTime) {
+		err := streamS3LogObjectLines(ctx, d, svc, bucketName, objectPrefix, func(key string, line string) bool {
+			// Skip the #Version and #Fields headers
+			if strings.HasPrefix(line, "#") {
+				return true
+			}
+			record := parseCloudFrontAccessLogRecord(line)
+			if record == nil {
+				plugin.Logger(ctx).Debug("aws_cloudfront_distribution_access_log.listCloudFrontDistributionAccessLogs", "key", key, "parse_error", "unexpected format")
+				return true
+			}
+			record.DistributionId = aws.String(distributionId)
+			record.LogBucketName = aws.String(bucketName)
+			record.LogObjectKey = aws.String(key)
+
+			d.StreamListItem(ctx, record)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_distribution_access_log.listCloudFrontDistributionAccessLogs", "log_bucket_name", bucketName, "prefix", objectPrefix, "api_error", err)
+			return nil, err
+		}
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontDistributionConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_distribution_access_log.getCloudFrontDistributionConfig", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.GetDistributionConfigInput{
+		Id: h.Item.(types.DistributionSummary).Id,
+	}
+
+	op, err := svc.GetDistributionConfig(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_distribution_access_log.getCloudFrontDistributionConfig", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// parseCloudFrontAccessLogRecord parses a record in the CloudFront standard
+// log format, which is tab separated in the W3C extended format. A - indicates
+// an unknown or empty value.
+// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html#LogFileFormat
+func parseCloudFrontAccessLogRecord(line string) *cloudFrontDistributionAccessLogRecord {
+	fields := strings.Split(line, "\t")
+	// Fields are only ever added to the end of the format, so older records
+	// have fewer of them
+	if len(fields) < 24 {
+		return nil
+	}
+	for len(fields) < 33 {
+		fields = append(fields, "-")
+	}
+
+	field := func(i int) *string {
+		if fields[i] == "-" || fields[i] == "" {
+			return nil
+		}
+		return aws.String(fields[i])
+	}
+	// Header values such as the user agent are URL encoded
+	decodedField := func(i int) *string {
+		if v := field(i); v != nil {
+			if decoded, err := url.PathUnescape(*v); err == nil {
+				return aws.String(decoded)
+			}
+			return v
+		}
+		return nil
+	}
+	intField := func(i int) *int64 {
+		v, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil
+		}
+		return aws.Int64(v)
+	}
+	floatField := func(i int) *float64 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil
+		}
+		return aws.Float64(v)
+	}
+
+	record := &cloudFrontDistributionAccessLogRecord{
+		EdgeLocation:           field(2),
+		BytesSent:              intField(3),
+		ClientIp:               field(4),
+		Method:                 field(5),
+		Host:                   field(6),
+		UriStem:                field(7),
+		HttpStatus:             intField(8),
+		Referer:                decodedField(9),
+		UserAgent:              decodedField(10),
+		QueryString:            field(11),
+		Cookie:                 decodedField(12),
+		EdgeResultType:         field(13),
+		RequestId:              field(14),
+		HostHeader:             field(15),
+		Protocol:               field(16),
+		BytesReceived:          intField(17),
+		TimeTaken:              floatField(18),
+		ForwardedFor:           field(19),
+		TlsVersion:             field(20),
+		TlsCipher:              field(21),
+		EdgeResponseResultType: field(22),
+		ProtocolVersion:        field(23),
+		FleStatus:              field(24),
+		FleEncryptedFields:     intField(25),
+		ClientPort:             intField(26),
+		TimeToFirstByte:        floatField(27),
+		EdgeDetailedResultType: field(28),
+		ContentType:            field(29),
+		ContentLength:          intField(30),
+		RangeStart:             intField(31),
+		RangeEnd:               intField(32),
+		Message:                line,
+	}
+	if t, err := time.Parse("2006-01-02 15:04:05", fields[0]+" "+fields[1]); err == nil {
+		record.Timestamp = &t
+	}
+
+	return record
+}
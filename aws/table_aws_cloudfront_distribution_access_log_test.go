@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestParseCloudFrontAccessLogRecord(t *testing.T) {
+	line := "2019-12-04\t21:02:31\tLAX1\t392\t192.0.2.100\tGET\td111111abcdef8.cloudfront.net\t/index.html\t200\t-\tMozilla/5.0%20(Windows%20NT%2010.0)\t-\t-\tHit\tSOX4xwn4XV6Q4rgb7XiVGOHms_BGlTAC4KyHmureZmBNrjGdRLiNIQ==\td111111abcdef8.cloudfront.net\thttps\t23\t0.001\t-\tTLSv1.2\tECDHE-RSA-AES128-GCM-SHA256\tHit\tHTTP/2.0\t-\t-\t11040\t0.001\tHit\ttext/html\t78\t-\t-"
+
+	record := parseCloudFrontAccessLogRecord(line)
+	if record == nil {
+		t.Fatal("expected record, got nil")
+	}
+	if *record.EdgeLocation != "LAX1" || *record.EdgeResultType != "Hit" || *record.TlsVersion != "TLSv1.2" {
+		t.Errorf("unexpected fields: %+v", record)
+	}
+	if *record.UserAgent != "Mozilla/5.0 (Windows NT 10.0)" {
+		t.Errorf("expected decoded user agent, got: %q", *record.UserAgent)
+	}
+	if *record.HttpStatus != 200 || *record.BytesSent != 392 || *record.ClientPort != 11040 || *record.TimeTaken != 0.001 {
+		t.Errorf("unexpected numeric fields: %+v", record)
+	}
+	if record.Timestamp == nil || record.Timestamp.Unix() != 1575493351 {
+		t.Errorf("unexpected timestamp: %v", record.Timestamp)
+	}
+	if record.RangeStart != nil || record.Referer != nil {
+		t.Errorf("expected - values to be nil: %+v", record)
+	}
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	cloudtrailv1 "github.com/aws/aws-sdk-go/service/cloudtrail"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Interval between polls of GetQueryResults while a Lake query is queued or running
+const cloudtrailLakeQueryPollIntervalMs = 1000
+
+//// TABLE DEFINITION
+
+func tableAwsCloudTrailLakeQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudtrail_lake_query",
+		Description: "AWS CloudTrail Lake Query",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudTrailLakeQueryResults,
+			Tags:    map[string]string{"service": "cloudtrail", "action": "StartQuery"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query_statement", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "event_data_store_arn", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InactiveEventDataStoreException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudtrailv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "query_statement",
+				Description: "The SQL statement run against the event data store. The FROM clause must reference the event data store ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query_statement"),
+			},
+			{
+				Name:        "query_id",
+				Description: "The ID of the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_data_store_arn",
+				Description: "The ARN of the event data store the query ran against.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query_status",
+				Description: "The status of the query. Values for QueryStatus include QUEUED, RUNNING, FINISHED, FAILED, TIMED_OUT, or CANCELLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bytes_scanned",
+				Description: "The total bytes that the query scanned in the event data store.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QueryStatistics.BytesScanned"),
+			},
+			{
+				Name:        "results_count",
+				Description: "The number of results returned.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QueryStatistics.ResultsCount"),
+			},
+			{
+				Name:        "total_results_count",
+				Description: "The total number of results returned by the query.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QueryStatistics.TotalResultsCount"),
+			},
+			{
+				Name:        "row_number",
+				Description: "The position of the row in the query results, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "event_id",
+				Description: "The ID of the event, if selected by the query as eventID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.eventID"),
+			},
+			{
+				Name:        "event_time",
+				Description: "The date and time the request was made, if selected by the query as eventTime.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Result.eventTime").Transform(cloudtrailLakeTimestamp),
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the event, if selected by the query as eventName.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.eventName"),
+			},
+			{
+				Name:        "event_source",
+				Description: "The AWS service that the request was made to, if selected by the query as eventSource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.eventSource"),
+			},
+			{
+				Name:        "aws_region",
+				Description: "The AWS region that the request was made to, if selected by the query as awsRegion.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.awsRegion"),
+			},
+			{
+				Name:        "source_ip_address",
+				Description: "The IP address that the request was made from, if selected by the query as sourceIPAddress.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.sourceIPAddress"),
+			},
+			{
+				Name:        "error_code",
+				Description: "The AWS service error if the request returned an error, if selected by the query as errorCode.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.errorCode"),
+			},
+			{
+				Name:        "recipient_account_id",
+				Description: "The account ID that received the event, if selected by the query as recipientAccountId.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result.recipientAccountId"),
+			},
+			{
+				Name:        "result",
+				Description: "The row returned by the query, keyed by the selected column names.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QueryId"),
+			},
+		}),
+	}
+}
+
+type cloudtrailLakeQueryRow struct {
+	QueryId           *string
+	EventDataStoreArn *string
+	QueryStatus       types.QueryStatus
+	QueryStatistics   *types.QueryStatistics
+	RowNumber         int
+	Result            map[string]interface{}
+}
+
+//// LIST FUNCTION
+
+func listCloudTrailLakeQueryResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	statement := d.EqualsQualString("query_statement")
+
+	// Get client
+	svc, err := CloudTrailClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudtrail_lake_query.listCloudTrailLakeQueryResults", "client_error", err)
+		return nil, err
+	}
+
+	// The statement can only run in the region of the event data stores it
+	// selects from, so it is started once, in the region of the event data store
+	// it references first
+	eventDataStore, err := getCloudTrailLakeQueryEventDataStore(ctx, d, svc, statement)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudtrail_lake_query.listCloudTrailLakeQueryResults", "api_error", err)
+		return nil, err
+	}
+	if eventDataStore == nil {
+		return nil, nil
+	}
+	if d.EqualsQualString("event_data_store_arn") != "" && d.EqualsQualString("event_data_store_arn") != *eventDataStore.EventDataStoreArn {
+		return nil, nil
+	}
+
+	startOutput, err := svc.StartQuery(ctx, &cloudtrail.StartQueryInput{
+		QueryStatement: aws.String(statement),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudtrail_lake_query.listCloudTrailLakeQueryResults", "start_query_error", err)
+		return nil, err
+	}
+
+	// Stop the query if no more rows are needed, or on cancellation or error,
+	// while it is still queued or running
+	queryStatus := types.QueryStatusQueued
+	defer func() {
+		if queryStatus == types.QueryStatusQueued || queryStatus == types.QueryStatusRunning {
+			cancelCloudTrailLakeQuery(ctx, svc, startOutput.QueryId)
+		}
+	}()
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudtrail.GetQueryResultsInput{
+		QueryId:         startOutput.QueryId,
+		MaxQueryResults: aws.Int32(maxLimit),
+	}
+
+	rowNumber := 0
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetQueryResults(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudtrail_lake_query.listCloudTrailLakeQueryResults", "api_error", err)
+			return nil, err
+		}
+		queryStatus = output.QueryStatus
+
+		switch output.QueryStatus {
+		case types.QueryStatusQueued, types.QueryStatusRunning:
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+
+			// if the query is still in progress, wait and retry
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(cloudtrailLakeQueryPollIntervalMs * time.Millisecond):
+			}
+			continue
+		case types.QueryStatusFailed, types.QueryStatusTimedOut, types.QueryStatusCancelled:
+			return nil, fmt.Errorf("CloudTrail Lake query %s %s: %s", *startOutput.QueryId, output.QueryStatus, aws.ToString(output.ErrorMessage))
+		}
+
+		for _, resultRow := range output.QueryResultRows {
+			rowNumber++
+
+			// Each row is returned as a list of single entry maps, one per selected column
+			result := map[string]interface{}{}
+			for _, column := range resultRow {
+				for k, v := range column {
+					result[k] = v
+				}
+			}
+
+			d.StreamListItem(ctx, &cloudtrailLakeQueryRow{
+				QueryId:           startOutput.QueryId,
+				EventDataStoreArn: eventDataStore.EventDataStoreArn,
+				QueryStatus:       output.QueryStatus,
+				QueryStatistics:   output.QueryStatistics,
+				RowNumber:         rowNumber,
+				Result:            result,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+// getCloudTrailLakeQueryEventDataStore returns the event data store of the
+// region that the statement references first, by ID or ARN, or nil if the
+// statement references none of them.
+func getCloudTrailLakeQueryEventDataStore(ctx context.Context, d *plugin.QueryData, svc *cloudtrail.Client, statement string) (*types.EventDataStore, error) {
+	paginator := cloudtrail.NewListEventDataStoresPaginator(svc, &cloudtrail.ListEventDataStoresInput{}, func(o *cloudtrail.ListEventDataStoresPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var eventDataStore *types.EventDataStore
+	firstIndex := len(statement)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i, item := range output.EventDataStores {
+			arnParts := strings.Split(*item.EventDataStoreArn, "/")
+			if index := strings.Index(statement, arnParts[len(arnParts)-1]); index >= 0 && index < firstIndex {
+				eventDataStore = &output.EventDataStores[i]
+				firstIndex = index
+			}
+		}
+	}
+
+	return eventDataStore, nil
+}
+
+// cancelCloudTrailLakeQuery stops a query that is no longer needed so it
+// doesn't keep scanning (and billing) after the Steampipe query no longer
+// needs its results.
+func cancelCloudTrailLakeQuery(ctx context.Context, svc *cloudtrail.Client, queryId *string) {
+	_, err := svc.CancelQuery(context.Background(), &cloudtrail.CancelQueryInput{
+		QueryId: queryId,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Warn("aws_cloudtrail_lake_query.cancelCloudTrailLakeQuery", "query_id", *queryId, "api_error", err)
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Lake returns timestamps in the form 2023-10-10 13:55:36.000
+func cloudtrailLakeTimestamp(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value, ok := d.Value.(string)
+	if !ok || value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02 15:04:05.999", value)
+	if err != nil {
+		return nil, nil
+	}
+	return t, nil
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchAlarmHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_alarm_history",
+		Description: "AWS CloudWatch Alarm History",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchAlarmHistory,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "DescribeAlarmHistory"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "alarm_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "alarm_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "history_item_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "timestamp",
+					Operators: []string{">", ">=", "=", "<", "<="},
+					Require:   plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "alarm_name",
+				Description: "The descriptive name for the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alarm_type",
+				Description: "The type of alarm, either metric alarm or composite alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time stamp for the alarm history item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "history_item_type",
+				Description: "The type of alarm history item, i.e. ConfigurationUpdate, StateUpdate or Action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "history_summary",
+				Description: "A summary of the alarm history, in text format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "history_data",
+				Description: "Data about the alarm, such as its old and new state for a state update.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HistoryData").Transform(transform.UnmarshalYAML),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchAlarmHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_alarm_history.listCloudWatchAlarmHistory", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.DescribeAlarmHistoryInput{
+		MaxRecords: aws.Int32(maxLimit),
+		ScanBy:     types.ScanByTimestampDescending,
+	}
+
+	// Additonal Filter
+	equalQuals := d.EqualsQuals
+	if equalQuals["alarm_name"] != nil {
+		params.AlarmName = aws.String(equalQuals["alarm_name"].GetStringValue())
+	}
+	if equalQuals["alarm_type"] != nil {
+		params.AlarmTypes = []types.AlarmType{types.AlarmType(equalQuals["alarm_type"].GetStringValue())}
+	}
+	if equalQuals["history_item_type"] != nil {
+		params.HistoryItemType = types.HistoryItemType(equalQuals["history_item_type"].GetStringValue())
+	}
+
+	// Set the start and end date based on the provided timestamp
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				params.StartDate = aws.Time(timestamp)
+				params.EndDate = aws.Time(timestamp.Add(time.Second))
+			case ">=", ">":
+				params.StartDate = aws.Time(timestamp)
+			case "<=":
+				params.EndDate = aws.Time(timestamp.Add(time.Second))
+			case "<":
+				params.EndDate = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := cloudwatch.NewDescribeAlarmHistoryPaginator(svc, params, func(o *cloudwatch.DescribeAlarmHistoryPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_alarm_history.listCloudWatchAlarmHistory", "api_error", err)
+			return nil, err
+		}
+		for _, item := range output.AlarmHistoryItems {
+			d.StreamListItem(ctx, item)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
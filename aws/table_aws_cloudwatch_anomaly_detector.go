@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchAnomalyDetector(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_anomaly_detector",
+		Description: "AWS CloudWatch Anomaly Detector",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchAnomalyDetectors,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "DescribeAnomalyDetectors"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "namespace",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "metric_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "namespace",
+				Description: "The namespace of the metric associated with the anomaly detection model, for single metric anomaly detectors.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Namespace"),
+			},
+			{
+				Name:        "metric_name",
+				Description: "The name of the metric associated with the anomaly detection model, for single metric anomaly detectors.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.MetricName"),
+			},
+			{
+				Name:        "stat",
+				Description: "The statistic associated with the anomaly detection model, for single metric anomaly detectors.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Stat"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the anomaly detector, either SINGLE_METRIC or METRIC_MATH.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudWatchAnomalyDetectorType),
+			},
+			{
+				Name:        "state_value",
+				Description: "The current status of the anomaly detector's training, i.e. PENDING_TRAINING, TRAINED_INSUFFICIENT_DATA or TRAINED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimensions",
+				Description: "The metric dimensions associated with the anomaly detection model, for single metric anomaly detectors.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Dimensions"),
+			},
+			{
+				Name:        "configuration",
+				Description: "The configuration of the anomaly detection model, i.e. the time ranges excluded from its training and the time zone.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metric_data_queries",
+				Description: "The metric math queries of the anomaly detection model, for metric math anomaly detectors.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MetricMathAnomalyDetector.MetricDataQueries"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.MetricName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchAnomalyDetectors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_anomaly_detector.listCloudWatchAnomalyDetectors", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.DescribeAnomalyDetectorsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// Additonal Filter
+	equalQuals := d.EqualsQuals
+	if equalQuals["namespace"] != nil {
+		params.Namespace = aws.String(equalQuals["namespace"].GetStringValue())
+	}
+	if equalQuals["metric_name"] != nil {
+		params.MetricName = aws.String(equalQuals["metric_name"].GetStringValue())
+	}
+	if equalQuals["type"] != nil {
+		params.AnomalyDetectorTypes = []types.AnomalyDetectorType{types.AnomalyDetectorType(equalQuals["type"].GetStringValue())}
+	}
+
+	paginator := cloudwatch.NewDescribeAnomalyDetectorsPaginator(svc, params, func(o *cloudwatch.DescribeAnomalyDetectorsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_anomaly_detector.listCloudWatchAnomalyDetectors", "api_error", err)
+			return nil, err
+		}
+		for _, detector := range output.AnomalyDetectors {
+			d.StreamListItem(ctx, detector)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudWatchAnomalyDetectorType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	detector := d.HydrateItem.(types.AnomalyDetector)
+	if detector.MetricMathAnomalyDetector != nil {
+		return types.AnomalyDetectorTypeMetricMath, nil
+	}
+	return types.AnomalyDetectorTypeSingleMetric, nil
+}
@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchCompositeAlarm(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_composite_alarm",
+		Description: "AWS CloudWatch Composite Alarm",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCloudWatchCompositeAlarm,
+			Tags:       map[string]string{"service": "cloudwatch", "action": "DescribeAlarms"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchCompositeAlarms,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "DescribeAlarms"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "state_value",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudWatchCompositeAlarmTags,
+				Tags: map[string]string{"service": "cloudwatch", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmArn"),
+			},
+			{
+				Name:        "state_value",
+				Description: "The state value for the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actions_enabled",
+				Description: "Indicates whether actions should be executed during any changes to the alarm state.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "actions_suppressed_by",
+				Description: "When the value is WaitPeriod, the actions are suppressed because the actions suppressor alarm is waiting to go into ALARM state. When the value is ExtensionPeriod, they are suppressed because the suppressor alarm is waiting after leaving ALARM state. When the value is Alarm, the suppressor alarm is in ALARM state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actions_suppressed_reason",
+				Description: "Captures the reason for action suppression.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actions_suppressor",
+				Description: "The alarm suppressing the actions of the composite alarm when it's in ALARM state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "actions_suppressor_extension_period",
+				Description: "The maximum time in seconds that the composite alarm waits after the suppressor alarm goes out of the ALARM state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "actions_suppressor_wait_period",
+				Description: "The maximum time in seconds that the composite alarm waits for the suppressor alarm to go into the ALARM state.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "alarm_configuration_updated_timestamp",
+				Description: "The time stamp of the last update to the alarm configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "alarm_description",
+				Description: "The description of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alarm_rule",
+				Description: "The rule that this alarm uses to evaluate its alarm state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_expression",
+				Description: "The parsed alarm rule, as a tree of AND, OR, NOT and AT_LEAST operators over the state of the child alarms. If the rule cannot be parsed, the raw rule and the parse error.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "child_alarm_arns",
+				Description: "The ARNs of the alarms referenced by the alarm rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "state_reason",
+				Description: "An explanation for the alarm state, in text format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason_data",
+				Description: "An explanation for the alarm state, in JSON format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_transitioned_timestamp",
+				Description: "The timestamp of the last change to the alarm's state value.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "state_updated_timestamp",
+				Description: "The time stamp of the last update to the alarm state, or to its state reason.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "alarm_actions",
+				Description: "The actions to execute when this alarm transitions to the ALARM state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "insufficient_data_actions",
+				Description: "The actions to execute when this alarm transitions to the INSUFFICIENT_DATA state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ok_actions",
+				Description: "The actions to execute when this alarm transitions to the OK state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OKActions"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tag keys and values associated with alarm.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchCompositeAlarmTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchCompositeAlarmTags,
+				Transform:   transform.From(getAwsCloudWatchAlarmTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlarmArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+type CompositeAlarmInfo struct {
+	types.CompositeAlarm
+	RuleExpression interface{}
+	ChildAlarmArns []string
+}
+
+// compositeAlarmRuleParseError is the rule expression of an alarm whose rule
+// cannot be parsed.
+type compositeAlarmRuleParseError struct {
+	Rule  string `json:"rule"`
+	Error string `json:"error"`
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchCompositeAlarms(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.listCloudWatchCompositeAlarms", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: []types.AlarmType{types.AlarmTypeCompositeAlarm},
+		MaxRecords: aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQuals["state_value"] != nil {
+		params.StateValue = types.StateValue(d.EqualsQuals["state_value"].GetStringValue())
+	}
+
+	paginator := cloudwatch.NewDescribeAlarmsPaginator(svc, params, func(o *cloudwatch.DescribeAlarmsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.listCloudWatchCompositeAlarms", "api_error", err)
+			return nil, err
+		}
+		for _, alarm := range output.CompositeAlarms {
+			d.StreamListItem(ctx, newCompositeAlarmInfo(ctx, alarm))
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchCompositeAlarm(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQuals["name"].GetStringValue()
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.getCloudWatchCompositeAlarm", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+		AlarmTypes: []types.AlarmType{types.AlarmTypeCompositeAlarm},
+	}
+
+	item, err := svc.DescribeAlarms(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.getCloudWatchCompositeAlarm", "api_error", err)
+		return nil, err
+	}
+
+	if len(item.CompositeAlarms) > 0 {
+		return newCompositeAlarmInfo(ctx, item.CompositeAlarms[0]), nil
+	}
+
+	return nil, nil
+}
+
+func getCloudWatchCompositeAlarmTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	alarm := h.Item.(*CompositeAlarmInfo)
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.getCloudWatchCompositeAlarmTags", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: alarm.AlarmArn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_composite_alarm.getCloudWatchCompositeAlarmTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// newCompositeAlarmInfo parses the rule of the alarm, and resolves the alarms
+// it references by name into ARNs in the region and account of the alarm.
+func newCompositeAlarmInfo(ctx context.Context, alarm types.CompositeAlarm) *CompositeAlarmInfo {
+	info := &CompositeAlarmInfo{CompositeAlarm: alarm}
+	if alarm.AlarmRule == nil {
+		return info
+	}
+
+	rule, err := parseCWAlarmRule(*alarm.AlarmRule)
+	if err != nil {
+		plugin.Logger(ctx).Warn("aws_cloudwatch_composite_alarm.newCompositeAlarmInfo", "parse_error", err, "alarm", aws.ToString(alarm.AlarmName))
+		info.RuleExpression = compositeAlarmRuleParseError{Rule: *alarm.AlarmRule, Error: err.Error()}
+		return info
+	}
+	info.RuleExpression = rule
+
+	arnPrefix := ""
+	if i := strings.LastIndex(aws.ToString(alarm.AlarmArn), ":alarm:"); i >= 0 {
+		arnPrefix = (*alarm.AlarmArn)[:i+len(":alarm:")]
+	}
+	for _, child := range rule.alarms() {
+		if !strings.HasPrefix(child, "arn:") {
+			child = arnPrefix + child
+		}
+		info.ChildAlarmArns = append(info.ChildAlarmArns, child)
+	}
+
+	return info
+}
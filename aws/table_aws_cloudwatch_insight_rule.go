@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchInsightRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_insight_rule",
+		Description: "AWS CloudWatch Contributor Insights Rule",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchInsightRules,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "DescribeInsightRules"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudWatchInsightRuleTags,
+				Tags: map[string]string{"service": "cloudwatch", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the rule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudWatchInsightRuleArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "state",
+				Description: "Indicates whether the rule is enabled or disabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_rule",
+				Description: "Indicates whether the rule is a managed rule, created by an AWS service.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "schema",
+				Description: "The schema of the rule definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "definition",
+				Description: "The definition of the rule, i.e. the log groups, keys and filters it uses.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Definition").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tag keys and values associated with the rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleTags,
+				Transform:   transform.From(getAwsCloudWatchAlarmTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchInsightRuleArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchInsightRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.listCloudWatchInsightRules", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.DescribeInsightRulesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cloudwatch.NewDescribeInsightRulesPaginator(svc, params, func(o *cloudwatch.DescribeInsightRulesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.listCloudWatchInsightRules", "api_error", err)
+			return nil, err
+		}
+		for _, rule := range output.InsightRules {
+			d.StreamListItem(ctx, rule)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchInsightRuleArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	rule := h.Item.(types.InsightRule)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// arn:aws:cloudwatch:us-east-1:123456789012:insight-rule/my-rule
+	arn := "arn:" + commonColumnData.Partition + ":cloudwatch:" + region + ":" + commonColumnData.AccountId + ":insight-rule/" + *rule.Name
+
+	return arn, nil
+}
+
+func getCloudWatchInsightRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn, err := getCloudWatchInsightRuleArn(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleTags", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: aws.String(arn.(string)),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_insight_rule.getCloudWatchInsightRuleTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
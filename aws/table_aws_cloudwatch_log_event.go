@@ -3,7 +3,10 @@ package aws
 import (
 	"context"
 	"encoding/json"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -16,18 +19,32 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"golang.org/x/sync/errgroup"
 )
 
 func tableAwsCloudwatchLogEventListKeyColumns() []*plugin.KeyColumn {
 	return []*plugin.KeyColumn{
-		{Name: "log_group_name"},
+		{Name: "log_group_name", Require: plugin.AnyOf},
+		{Name: "log_group_name_prefix", Require: plugin.AnyOf, CacheMatch: "exact"},
 		{Name: "log_stream_name", Require: plugin.Optional},
 		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+		{Name: "parse_format", Require: plugin.Optional, CacheMatch: "exact"},
 		{Name: "region", Require: plugin.Optional},
 		{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
 	}
 }
 
+// The maximum number of log groups searched concurrently by a single query
+const maxConcurrentLogGroupSearches = 10
+
+// cloudwatchLogEvent is a FilterLogEvents result together with the log group
+// it was found in, since the API doesn't return it for each event.
+type cloudwatchLogEvent struct {
+	cloudwatchlogsTypes.FilteredLogEvent
+	LogGroupName *string
+}
+
 func tableAwsCloudwatchLogEvent(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_cloudwatch_log_event",
@@ -46,9 +63,14 @@ func tableAwsCloudwatchLogEvent(_ context.Context) *plugin.Table {
 			{
 				Name:        "log_group_name",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("log_group_name"),
 				Description: "The name of the log group to which this event belongs.",
 			},
+			{
+				Name:        "log_group_name_prefix",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("log_group_name_prefix"),
+				Description: "The prefix used to match the log groups searched by the query.",
+			},
 			{
 				Name:        "log_stream_name",
 				Type:        proto.ColumnType_STRING,
@@ -89,6 +111,18 @@ func tableAwsCloudwatchLogEvent(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("Message").Transform(trim).Transform(cloudwatchLogsMesssageJson),
 				Description: "The data contained in the log event in json format. Only if data is valid json string.",
 			},
+			{
+				Name:        "parse_format",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("parse_format"),
+				Description: "The format used to parse the message into message_parsed. Possible values are: lambda_report, combined and logfmt.",
+			},
+			{
+				Name:        "message_parsed",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Message").Transform(trim).Transform(cloudwatchLogsMessageParsed),
+				Description: "The data contained in the log event parsed according to parse_format. Only if the message matches the format.",
+			},
 			// Other columns
 		}),
 	}
@@ -111,6 +145,12 @@ func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin
 		return nil, err
 	}
 
+	logGroupNames, err := listCloudwatchLogEventGroupNames(ctx, d, svc)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_log_event.listCloudwatchLogEvents", "api_error", err)
+		return nil, err
+	}
+
 	equalQuals := d.EqualsQuals
 
 	// Limiting the results
@@ -127,8 +167,7 @@ func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin
 	}
 
 	params := &cloudwatchlogs.FilterLogEventsInput{
-		LogGroupName: aws.String(equalQuals["log_group_name"].GetStringValue()),
-		Limit:        aws.Int32(maxLimit),
+		Limit: aws.Int32(maxLimit),
 	}
 
 	if equalQuals["log_stream_name"] != nil {
@@ -161,36 +200,110 @@ func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin
 		}
 	}
 
-	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(svc, params, func(o *cloudwatchlogs.FilterLogEventsPaginatorOptions) {
+	// Search the log groups concurrently, bounded by maxConcurrentLogGroupSearches.
+	// The first error cancels the searches of the other log groups.
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLogGroupSearches)
+
+	for _, logGroupName := range logGroupNames {
+		// Context may get cancelled due to manual cancellation, an error or if the limit has been reached
+		if d.RowsRemaining(gctx) == 0 {
+			break
+		}
+
+		input := *params
+		input.LogGroupName = aws.String(logGroupName)
+		g.Go(func() error {
+			return filterCloudwatchLogGroupEvents(gctx, d, svc, &input)
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func filterCloudwatchLogGroupEvents(ctx context.Context, d *plugin.QueryData, svc *cloudwatchlogs.Client, input *cloudwatchlogs.FilterLogEventsInput) error {
+	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(svc, input, func(o *cloudwatchlogs.FilterLogEventsPaginatorOptions) {
 		o.StopOnDuplicateToken = true
 	})
 
 	// List call
 	for paginator.HasMorePages() {
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
 
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("aws_cloudwatch_alarm.listCloudWatchAlarms", "api_error", err)
-			return nil, err
+			plugin.Logger(ctx).Error("aws_cloudwatch_log_event.filterCloudwatchLogGroupEvents", "log_group_name", *input.LogGroupName, "api_error", err)
+			return err
 		}
 		for _, logEvent := range output.Events {
-			d.StreamListItem(ctx, logEvent)
+			d.StreamListItem(ctx, cloudwatchLogEvent{logEvent, input.LogGroupName})
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
 			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				return nil
 			}
 		}
 	}
 
-	return nil, err
+	return nil
+}
+
+// listCloudwatchLogEventGroupNames returns the log groups to search, either
+// from the log_group_name qual (a single name or a list of names) or all the
+// log groups matching log_group_name_prefix.
+func listCloudwatchLogEventGroupNames(ctx context.Context, d *plugin.QueryData, svc *cloudwatchlogs.Client) ([]string, error) {
+	logGroupNames := []string{}
+
+	if d.EqualsQuals["log_group_name"] != nil {
+		if listValue := d.EqualsQuals["log_group_name"].GetListValue(); listValue != nil {
+			for _, v := range listValue.Values {
+				logGroupNames = append(logGroupNames, v.GetStringValue())
+			}
+		} else {
+			logGroupNames = append(logGroupNames, d.EqualsQualString("log_group_name"))
+		}
+		return logGroupNames, nil
+	}
+
+	input := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(d.EqualsQualString("log_group_name_prefix")),
+		Limit:              aws.Int32(50),
+	}
+
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(svc, input, func(o *cloudwatchlogs.DescribeLogGroupsPaginatorOptions) {
+		o.Limit = 50
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, logGroup := range output.LogGroups {
+			logGroupNames = append(logGroupNames, *logGroup.LogGroupName)
+		}
+	}
+
+	return logGroupNames, nil
 }
 
 //// TRANSFORM FUNCTIONS
 
 func cloudwatchLogsMesssageJson(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	event := d.HydrateItem.(cloudwatchlogsTypes.FilteredLogEvent)
+	event := d.HydrateItem.(cloudwatchLogEvent)
 	var eventMessage interface{}
 	err := json.Unmarshal([]byte(*event.Message), &eventMessage)
 	if err != nil {
@@ -198,3 +311,168 @@ func cloudwatchLogsMesssageJson(_ context.Context, d *transform.TransformData) (
 	}
 	return eventMessage, nil
 }
+
+func cloudwatchLogsMessageParsed(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil || len(d.KeyColumnQuals["parse_format"]) == 0 {
+		return nil, nil
+	}
+	message := d.Value.(string)
+
+	switch d.KeyColumnQuals["parse_format"][0].Value.GetStringValue() {
+	case "lambda_report":
+		return parseLambdaReportLogMessage(message), nil
+	case "combined":
+		return parseCombinedLogMessage(message), nil
+	case "logfmt":
+		return parseLogfmtLogMessage(message), nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// parseLambdaReportLogMessage parses the REPORT line Lambda writes at the end
+// of each invocation, e.g.
+// REPORT RequestId: 3604209a-e9a3-11e6-939a-754dd98c7be3	Duration: 12.34 ms	Billed Duration: 100 ms	Memory Size: 128 MB	Max Memory Used: 18 MB
+// Numeric fields are suffixed with their unit, e.g. duration_ms.
+func parseLambdaReportLogMessage(message string) map[string]interface{} {
+	if !strings.HasPrefix(message, "REPORT ") {
+		return nil
+	}
+
+	parsed := map[string]interface{}{}
+	for _, field := range strings.Split(strings.TrimPrefix(message, "REPORT "), "\t") {
+		key, value, found := strings.Cut(strings.TrimSpace(field), ": ")
+		if !found {
+			continue
+		}
+		// RequestId, TraceId and SegmentId become request_id, trace_id and segment_id
+		key = strings.TrimSpace(key)
+		if strings.HasSuffix(key, "Id") {
+			key = strings.TrimSuffix(key, "Id") + " Id"
+		}
+		key = strings.ToLower(strings.ReplaceAll(key, " ", "_"))
+		// XRAY TraceId: 1-5e...	SegmentId: 2c...	Sampled: true
+		key = strings.TrimPrefix(key, "xray_")
+
+		if number, unit, found := strings.Cut(value, " "); found {
+			if f, err := strconv.ParseFloat(number, 64); err == nil {
+				parsed[key+"_"+strings.ToLower(unit)] = f
+				continue
+			}
+		}
+		if b, err := strconv.ParseBool(value); err == nil {
+			parsed[key] = b
+			continue
+		}
+		parsed[key] = value
+	}
+
+	if len(parsed) == 0 {
+		return nil
+	}
+	return parsed
+}
+
+// Apache/nginx combined log format, with the referer and user agent optional
+// to also match the common log format.
+var combinedLogFormatRegex = regexp.MustCompile(`^(\S+) (\S+) (\S+) \[([^\]]+)\] "([^"]*)" (\d{3}) (\d+|-)(?: "([^"]*)" "([^"]*)")?`)
+
+func parseCombinedLogMessage(message string) map[string]interface{} {
+	match := combinedLogFormatRegex.FindStringSubmatch(message)
+	if match == nil {
+		return nil
+	}
+
+	parsed := map[string]interface{}{
+		"remote_addr": match[1],
+		"request":     match[5],
+	}
+	if match[3] != "-" {
+		parsed["remote_user"] = match[3]
+	}
+	if t, err := time.Parse("02/Jan/2006:15:04:05 -0700", match[4]); err == nil {
+		parsed["time_local"] = t.Format(time.RFC3339)
+	} else {
+		parsed["time_local"] = match[4]
+	}
+	if method, rest, found := strings.Cut(match[5], " "); found {
+		parsed["method"] = method
+		path, protocol, _ := strings.Cut(rest, " ")
+		parsed["path"] = path
+		if protocol != "" {
+			parsed["protocol"] = protocol
+		}
+	}
+	if status, err := strconv.Atoi(match[6]); err == nil {
+		parsed["status"] = status
+	}
+	if bytes, err := strconv.Atoi(match[7]); err == nil {
+		parsed["body_bytes_sent"] = bytes
+	}
+	if match[8] != "" && match[8] != "-" {
+		parsed["http_referer"] = match[8]
+	}
+	if match[9] != "" && match[9] != "-" {
+		parsed["http_user_agent"] = match[9]
+	}
+
+	return parsed
+}
+
+// parseLogfmtLogMessage parses key=value pairs as written by logfmt loggers,
+// e.g. level=info msg="request complete" duration=12ms. Keys without a value
+// are set to true.
+func parseLogfmtLogMessage(message string) map[string]interface{} {
+	parsed := map[string]interface{}{}
+
+	i := 0
+	for i < len(message) {
+		// skip whitespace between pairs
+		for i < len(message) && message[i] == ' ' {
+			i++
+		}
+		start := i
+		for i < len(message) && message[i] != '=' && message[i] != ' ' {
+			i++
+		}
+		key := message[start:i]
+		if key == "" {
+			i++
+			continue
+		}
+		if i >= len(message) || message[i] == ' ' {
+			parsed[key] = true
+			continue
+		}
+
+		// skip '='
+		i++
+		if i < len(message) && message[i] == '"' {
+			i++
+			var value strings.Builder
+			for i < len(message) && message[i] != '"' {
+				if message[i] == '\\' && i+1 < len(message) {
+					i++
+				}
+				value.WriteByte(message[i])
+				i++
+			}
+			// skip closing quote
+			i++
+			parsed[key] = value.String()
+			continue
+		}
+		start = i
+		for i < len(message) && message[i] != ' ' {
+			i++
+		}
+		parsed[key] = message[start:i]
+	}
+
+	if len(parsed) == 0 {
+		return nil
+	}
+	return parsed
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLambdaReportLogMessage(t *testing.T) {
+	message := "REPORT RequestId: 3604209a-e9a3-11e6-939a-754dd98c7be3\tDuration: 12.34 ms\tBilled Duration: 13 ms\tMemory Size: 128 MB\tMax Memory Used: 18 MB\tInit Duration: 120.5 ms"
+	expected := map[string]interface{}{
+		"request_id":         "3604209a-e9a3-11e6-939a-754dd98c7be3",
+		"duration_ms":        12.34,
+		"billed_duration_ms": float64(13),
+		"memory_size_mb":     float64(128),
+		"max_memory_used_mb": float64(18),
+		"init_duration_ms":   120.5,
+	}
+
+	parsed := parseLambdaReportLogMessage(message)
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf("unexpected result: %v", parsed)
+	}
+
+	if parsed := parseLambdaReportLogMessage("START RequestId: 3604209a Version: $LATEST"); parsed != nil {
+		t.Errorf("expected nil for non REPORT line, got: %v", parsed)
+	}
+}
+
+func TestParseCombinedLogMessage(t *testing.T) {
+	message := `203.0.113.12 - frank [10/Oct/2023:13:55:36 -0700] "GET /index.html HTTP/1.1" 200 2326 "http://example.com/start" "Mozilla/5.0"`
+	expected := map[string]interface{}{
+		"remote_addr":     "203.0.113.12",
+		"remote_user":     "frank",
+		"time_local":      "2023-10-10T13:55:36-07:00",
+		"request":         "GET /index.html HTTP/1.1",
+		"method":          "GET",
+		"path":            "/index.html",
+		"protocol":        "HTTP/1.1",
+		"status":          200,
+		"body_bytes_sent": 2326,
+		"http_referer":    "http://example.com/start",
+		"http_user_agent": "Mozilla/5.0",
+	}
+
+	parsed := parseCombinedLogMessage(message)
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf("unexpected result: %v", parsed)
+	}
+}
+
+func TestParseLogfmtLogMessage(t *testing.T) {
+	message := `level=info msg="request \"complete\"" duration=12ms cached`
+	expected := map[string]interface{}{
+		"level":    "info",
+		"msg":      `request "complete"`,
+		"duration": "12ms",
+		"cached":   true,
+	}
+
+	parsed := parseLogfmtLogMessage(message)
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf("unexpected result: %v", parsed)
+	}
+}
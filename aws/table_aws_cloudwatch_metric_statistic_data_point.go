@@ -66,11 +66,6 @@ func tableAwsCloudWatchMetricStatisticDataPoint(_ context.Context) *plugin.Table
 				Description: "A label for the specified metric.",
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "period",
-				Description: "The granularity, in seconds, of the returned data points.",
-				Type:        proto.ColumnType_INT,
-			},
 			{
 				Name:        "dimensions",
 				Description: "The dimensions for the metric.",
@@ -221,7 +216,7 @@ func listCloudWatchMetricStatisticDataPoints(ctx context.Context, d *plugin.Quer
 		return nil, err
 	}
 
-	statistics, err := svc.GetMetricStatistics(ctx, params)
+	statistics, err := getCWMetricStatisticsWithExtended(ctx, svc, params, cwMetricRequestedExtendedStatistics(d.QueryContext.Columns))
 	if err != nil {
 		plugin.Logger(ctx).Error("listCloudWatchMetricStatisticDataPoints", "api_error", err)
 		return nil, err
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	cloudwatchv1 "github.com/aws/aws-sdk-go/service/cloudwatch"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchMetricStream(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_metric_stream",
+		Description: "AWS CloudWatch Metric Stream",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCloudWatchMetricStream,
+			Tags:       map[string]string{"service": "cloudwatch", "action": "GetMetricStream"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchMetricStreams,
+			Tags:    map[string]string{"service": "cloudwatch", "action": "ListMetricStreams"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCloudWatchMetricStream,
+				Tags: map[string]string{"service": "cloudwatch", "action": "GetMetricStream"},
+			},
+			{
+				Func: getCloudWatchMetricStreamTags,
+				Tags: map[string]string{"service": "cloudwatch", "action": "ListTagsForResource"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the stream, either running or stopped.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date that the metric stream was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_date",
+				Description: "The date of the most recent update to the metric stream's configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "firehose_arn",
+				Description: "The ARN of the Kinesis Data Firehose delivery stream that is used by the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_format",
+				Description: "The output format of the metric stream, i.e. json or opentelemetry0.7.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that is used by the metric stream.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudWatchMetricStream,
+			},
+			{
+				Name:        "include_linked_accounts_metrics",
+				Description: "Indicates whether the metric stream includes metrics from source accounts that are linked to the monitoring account.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCloudWatchMetricStream,
+			},
+			{
+				Name:        "include_filters",
+				Description: "The namespaces and metrics streamed. If empty, all metrics are streamed except those in the exclude filters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStream,
+			},
+			{
+				Name:        "exclude_filters",
+				Description: "The namespaces and metrics excluded from the stream.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStream,
+			},
+			{
+				Name:        "statistics_configurations",
+				Description: "The additional statistics streamed for the metrics, on top of the default minimum, maximum, sample count and sum.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStream,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tag keys and values associated with the metric stream.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStreamTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStreamTags,
+				Transform:   transform.From(getAwsCloudWatchAlarmTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchMetricStreams(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.listCloudWatchMetricStreams", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &cloudwatch.ListMetricStreamsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cloudwatch.NewListMetricStreamsPaginator(svc, params, func(o *cloudwatch.ListMetricStreamsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.listCloudWatchMetricStreams", "api_error", err)
+			return nil, err
+		}
+		for _, stream := range output.Entries {
+			d.StreamListItem(ctx, stream)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchMetricStream(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.MetricStreamEntry).Name
+	} else {
+		name = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStream", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.GetMetricStreamInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetMetricStream(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStream", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudWatchMetricStreamTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.MetricStreamEntry:
+		arn = item.Arn
+	case *cloudwatch.GetMetricStreamOutput:
+		arn = item.Arn
+	}
+
+	// Create session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamTags", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
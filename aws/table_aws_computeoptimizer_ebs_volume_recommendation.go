@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerEbsVolumeRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_ebs_volume_recommendation",
+		Description: "AWS Compute Optimizer EBS Volume Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerEbsVolumeRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetEBSVolumeRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "volume_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns(computeOptimizerColumns([]*plugin.Column{
+			{
+				Name:        "volume_arn",
+				Description: "The Amazon Resource Name (ARN) of the current volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_volume_type",
+				Description: "The volume type of the current volume.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentConfiguration.VolumeType"),
+			},
+			{
+				Name:        "current_volume_size",
+				Description: "The size of the current volume, in GiB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentConfiguration.VolumeSize"),
+			},
+			{
+				Name:        "recommended_volume_type",
+				Description: "The volume type of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.Configuration.VolumeType"),
+			},
+			{
+				Name:        "recommended_volume_size",
+				Description: "The size of the volume of the top ranked recommendation option, in GiB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Option.Configuration.VolumeSize"),
+			},
+			{
+				Name:        "performance_risk",
+				Description: "The performance risk of the top ranked recommendation option, from 0 (very low) to 4 (high).",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Option.PerformanceRisk"),
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the volume.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "current_configuration",
+				Description: "The type, size, IOPS and throughput of the current volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommended_configuration",
+				Description: "The type, size, IOPS and throughput of the volume of the top ranked recommendation option.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Option.Configuration"),
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "The utilization metrics of the volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "volume_recommendation_options",
+				Description: "All the recommendation options for the volume, ranked by their ability to meet the workload requirements.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VolumeArn"),
+			},
+		})),
+	}
+}
+
+type computeOptimizerEbsVolumeRecommendation struct {
+	*types.VolumeRecommendation
+	Option *types.VolumeRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerEbsVolumeRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_ebs_volume_recommendation.listComputeOptimizerEbsVolumeRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &computeoptimizer.GetEBSVolumeRecommendationsInput{
+		VolumeArns: computeOptimizerArnsInput(d, "volume_arn"),
+		MaxResults: computeOptimizerLimit(d),
+	}
+	if finding := computeOptimizerFinding(d); finding != nil {
+		input.Filters = []types.EBSFilter{{Name: types.EBSFilterNameFinding, Values: finding}}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetEBSVolumeRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_ebs_volume_recommendation.listComputeOptimizerEbsVolumeRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.VolumeRecommendations {
+			recommendation := &output.VolumeRecommendations[i]
+			item := computeOptimizerEbsVolumeRecommendation{VolumeRecommendation: recommendation}
+			for j := range recommendation.VolumeRecommendationOptions {
+				option := &recommendation.VolumeRecommendationOptions[j]
+				if item.Option == nil || option.Rank < item.Option.Rank {
+					item.Option = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
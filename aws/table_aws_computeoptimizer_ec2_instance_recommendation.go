@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerEc2InstanceRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_ec2_instance_recommendation",
+		Description: "AWS Compute Optimizer EC2 Instance Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerEc2InstanceRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetEC2InstanceRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "instance_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns(computeOptimizerColumns([]*plugin.Column{
+			{
+				Name:        "instance_arn",
+				Description: "The Amazon Resource Name (ARN) of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_instance_type",
+				Description: "The instance type of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommended_instance_type",
+				Description: "The instance type of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.InstanceType"),
+			},
+			{
+				Name:        "performance_risk",
+				Description: "The performance risk of the top ranked recommendation option, from 0 (very low) to 4 (high).",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Option.PerformanceRisk"),
+			},
+			{
+				Name:        "migration_effort",
+				Description: "The level of effort required to migrate to the top ranked recommendation option. Possible values are: VeryLow, Low, Medium, High.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.MigrationEffort"),
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the instance, such as CPUOverprovisioned or MemoryUnderprovisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "platform_differences",
+				Description: "The differences between the current instance and the top ranked recommendation option, such as Hypervisor or NetworkInterface.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Option.PlatformDifferences"),
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "inferred_workload_types",
+				Description: "The applications that might be running on the instance, as inferred by Compute Optimizer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "The utilization metrics of the current instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "projected_utilization_metrics",
+				Description: "The projected utilization metrics of the top ranked recommendation option.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Option.ProjectedUtilizationMetrics"),
+			},
+			{
+				Name:        "recommendation_options",
+				Description: "All the recommendation options for the instance, ranked by their ability to meet the workload requirements.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommendation_sources",
+				Description: "The sources of the recommendation, such as an Auto Scaling group the instance belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "effective_recommendation_preferences",
+				Description: "The effective recommendation preferences for the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceArn"),
+			},
+		})),
+	}
+}
+
+type computeOptimizerEc2InstanceRecommendation struct {
+	*types.InstanceRecommendation
+	Option *types.InstanceRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerEc2InstanceRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_ec2_instance_recommendation.listComputeOptimizerEc2InstanceRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &computeoptimizer.GetEC2InstanceRecommendationsInput{
+		InstanceArns: computeOptimizerArnsInput(d, "instance_arn"),
+		MaxResults:   computeOptimizerLimit(d),
+	}
+	if finding := computeOptimizerFinding(d); finding != nil {
+		input.Filters = []types.Filter{{Name: types.FilterNameFinding, Values: finding}}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetEC2InstanceRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_ec2_instance_recommendation.listComputeOptimizerEc2InstanceRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.InstanceRecommendations {
+			recommendation := &output.InstanceRecommendations[i]
+			item := computeOptimizerEc2InstanceRecommendation{InstanceRecommendation: recommendation}
+			for j := range recommendation.RecommendationOptions {
+				option := &recommendation.RecommendationOptions[j]
+				if item.Option == nil || option.Rank < item.Option.Rank {
+					item.Option = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerEcsServiceRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_ecs_service_recommendation",
+		Description: "AWS Compute Optimizer ECS Service Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerEcsServiceRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetECSServiceRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns(computeOptimizerColumns([]*plugin.Column{
+			{
+				Name:        "service_arn",
+				Description: "The Amazon Resource Name (ARN) of the current ECS service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_type",
+				Description: "The launch type the ECS service is using. Compute Optimizer only supports the Fargate launch type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "task_definition_arn",
+				Description: "The task definition ARN used by the tasks in the ECS service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentServiceConfiguration.TaskDefinitionArn"),
+			},
+			{
+				Name:        "current_cpu",
+				Description: "The number of CPU units used by the tasks in the ECS service.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentServiceConfiguration.Cpu"),
+			},
+			{
+				Name:        "current_memory",
+				Description: "The amount of memory used by the tasks in the ECS service.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentServiceConfiguration.Memory"),
+			},
+			{
+				Name:        "recommended_cpu",
+				Description: "The CPU size of the top recommendation option.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Option.Cpu"),
+			},
+			{
+				Name:        "recommended_memory",
+				Description: "The memory size of the top recommendation option.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Option.Memory"),
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the ECS service, such as CPUOverprovisioned or MemoryUnderprovisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the ECS service.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("LookbackPeriodInDays"),
+			},
+			{
+				Name:        "current_service_configuration",
+				Description: "The configuration of the current ECS service, including its containers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "The utilization metrics of the ECS service.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "projected_utilization_metrics",
+				Description: "The projected utilization metrics of the top recommendation option.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Option.ProjectedUtilizationMetrics"),
+			},
+			{
+				Name:        "service_recommendation_options",
+				Description: "All the recommendation options for the ECS service.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceArn"),
+			},
+		})),
+	}
+}
+
+type computeOptimizerEcsServiceRecommendation struct {
+	*types.ECSServiceRecommendation
+	Option *types.ECSServiceRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerEcsServiceRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_ecs_service_recommendation.listComputeOptimizerEcsServiceRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &computeoptimizer.GetECSServiceRecommendationsInput{
+		ServiceArns: computeOptimizerArnsInput(d, "service_arn"),
+		MaxResults:  computeOptimizerLimit(d),
+	}
+	if finding := computeOptimizerFinding(d); finding != nil {
+		input.Filters = []types.ECSServiceRecommendationFilter{{Name: types.ECSServiceRecommendationFilterNameFinding, Values: finding}}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetECSServiceRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_ecs_service_recommendation.listComputeOptimizerEcsServiceRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.EcsServiceRecommendations {
+			recommendation := &output.EcsServiceRecommendations[i]
+			item := computeOptimizerEcsServiceRecommendation{ECSServiceRecommendation: recommendation}
+
+			// The options aren't ranked, so the one with the highest savings is used
+			for j := range recommendation.ServiceRecommendationOptions {
+				option := &recommendation.ServiceRecommendationOptions[j]
+				if item.Option == nil || ecsServiceOptionSavings(option) > ecsServiceOptionSavings(item.Option) {
+					item.Option = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ecsServiceOptionSavings(option *types.ECSServiceRecommendationOption) float64 {
+	if option.SavingsOpportunity == nil || option.SavingsOpportunity.EstimatedMonthlySavings == nil {
+		return 0
+	}
+	return option.SavingsOpportunity.EstimatedMonthlySavings.Value
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerLambdaFunctionRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_lambda_function_recommendation",
+		Description: "AWS Compute Optimizer Lambda Function Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerLambdaFunctionRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetLambdaFunctionRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns(computeOptimizerColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The unqualified Amazon Resource Name (ARN) of the function, without the version suffix.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaFunctionUnqualifiedArn),
+			},
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the current function, including its version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_version",
+				Description: "The version number of the current function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_memory_size",
+				Description: "The amount of memory, in MB, that's allocated to the current function.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "recommended_memory_size",
+				Description: "The memory size, in MB, of the top ranked recommendation option.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Option.MemorySize"),
+			},
+			{
+				Name:        "number_of_invocations",
+				Description: "The number of times your function code was applied during the look-back period.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the function, such as MemoryOverprovisioned or InsufficientData.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the function.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("LookbackPeriodInDays"),
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "The utilization metrics of the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "projected_utilization_metrics",
+				Description: "The projected utilization metrics of the top ranked recommendation option.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Option.ProjectedUtilizationMetrics"),
+			},
+			{
+				Name:        "memory_size_recommendation_options",
+				Description: "All the memory recommendation options for the function, ranked by their ability to meet the workload requirements.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn"),
+			},
+		})),
+	}
+}
+
+type computeOptimizerLambdaFunctionRecommendation struct {
+	*types.LambdaFunctionRecommendation
+	Option *types.LambdaFunctionMemoryRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerLambdaFunctionRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_lambda_function_recommendation.listComputeOptimizerLambdaFunctionRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// An unqualified function ARN returns the recommendations of $LATEST
+	input := &computeoptimizer.GetLambdaFunctionRecommendationsInput{
+		FunctionArns: computeOptimizerArnsInput(d, "arn"),
+		MaxResults:   computeOptimizerLimit(d),
+	}
+	if finding := computeOptimizerFinding(d); finding != nil {
+		input.Filters = []types.LambdaFunctionRecommendationFilter{{Name: types.LambdaFunctionRecommendationFilterNameFinding, Values: finding}}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetLambdaFunctionRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_lambda_function_recommendation.listComputeOptimizerLambdaFunctionRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.LambdaFunctionRecommendations {
+			recommendation := &output.LambdaFunctionRecommendations[i]
+			item := computeOptimizerLambdaFunctionRecommendation{LambdaFunctionRecommendation: recommendation}
+			for j := range recommendation.MemorySizeRecommendationOptions {
+				option := &recommendation.MemorySizeRecommendationOptions[j]
+				if item.Option == nil || option.Rank < item.Option.Rank {
+					item.Option = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// lambdaFunctionUnqualifiedArn strips the version or alias suffix of a
+// function ARN, e.g. arn:aws:lambda:us-east-1:123456789012:function:fn:$LATEST,
+// so that it matches the arn column of aws_lambda_function.
+func lambdaFunctionUnqualifiedArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, ok := d.Value.(*string)
+	if !ok || arn == nil {
+		return nil, nil
+	}
+	parts := strings.Split(*arn, ":")
+	if len(parts) > 7 {
+		parts = parts[:7]
+	}
+	return strings.Join(parts, ":"), nil
+}
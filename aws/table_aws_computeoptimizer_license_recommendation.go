@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerLicenseRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_license_recommendation",
+		Description: "AWS Compute Optimizer License Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerLicenseRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetLicenseRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+				{Name: "license_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the instance the license runs on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding",
+				Description: "The finding classification of the license. Possible values are: InsufficientMetrics, Optimized, NotOptimized.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_name",
+				Description: "The name of the current license, such as SQLServer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentLicenseConfiguration.LicenseName"),
+			},
+			{
+				Name:        "current_license_edition",
+				Description: "The edition of the current license, such as Enterprise or Standard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentLicenseConfiguration.LicenseEdition"),
+			},
+			{
+				Name:        "current_license_model",
+				Description: "The model of the current license, LicenseIncluded or BringYourOwnLicense.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentLicenseConfiguration.LicenseModel"),
+			},
+			{
+				Name:        "recommended_license_edition",
+				Description: "The license edition of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.LicenseEdition"),
+			},
+			{
+				Name:        "recommended_license_model",
+				Description: "The license model of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.LicenseModel"),
+			},
+			{
+				Name:        "estimated_monthly_savings_amount",
+				Description: "The estimated monthly savings possible by adopting the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Option.SavingsOpportunity.EstimatedMonthlySavings.Value"),
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Option.SavingsOpportunity.EstimatedMonthlySavings.Currency"),
+			},
+			{
+				Name:        "savings_opportunity_percentage",
+				Description: "The estimated monthly savings possible by adopting the top ranked recommendation option, as a percentage of the current cost.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Option.SavingsOpportunity.SavingsOpportunityPercentage"),
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the license.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("LookbackPeriodInDays"),
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the license, such as InvalidCloudWatchApplicationInsightsSetup or LicenseOverprovisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "current_license_configuration",
+				Description: "The configuration of the current license, including the instance type, number of cores and operating system of the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "license_recommendation_options",
+				Description: "All the recommendation options for the license, ranked by their savings.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceArn"),
+			},
+		}),
+	}
+}
+
+type computeOptimizerLicenseRecommendation struct {
+	*types.LicenseRecommendation
+	Option *types.LicenseRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerLicenseRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_license_recommendation.listComputeOptimizerLicenseRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &computeoptimizer.GetLicenseRecommendationsInput{
+		ResourceArns: computeOptimizerArnsInput(d, "resource_arn"),
+		MaxResults:   computeOptimizerLimit(d),
+	}
+	if finding := computeOptimizerFinding(d); finding != nil {
+		input.Filters = append(input.Filters, types.LicenseRecommendationFilter{Name: types.LicenseRecommendationFilterNameLicenseFinding, Values: finding})
+	}
+	if licenseName := d.EqualsQualString("license_name"); licenseName != "" {
+		input.Filters = append(input.Filters, types.LicenseRecommendationFilter{Name: types.LicenseRecommendationFilterNameLicenseName, Values: []string{licenseName}})
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetLicenseRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_license_recommendation.listComputeOptimizerLicenseRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.LicenseRecommendations {
+			recommendation := &output.LicenseRecommendations[i]
+			item := computeOptimizerLicenseRecommendation{LicenseRecommendation: recommendation}
+			for j := range recommendation.LicenseRecommendationOptions {
+				option := &recommendation.LicenseRecommendationOptions[j]
+				if item.Option == nil || option.Rank < item.Option.Rank {
+					item.Option = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
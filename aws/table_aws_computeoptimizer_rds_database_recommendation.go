@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	computeoptimizerv1 "github.com/aws/aws-sdk-go/service/computeoptimizer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerRdsDatabaseRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_rds_database_recommendation",
+		Description: "AWS Compute Optimizer RDS Database Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate:      listComputeOptimizerRdsDatabaseRecommendations,
+			Tags:         map[string]string{"service": "compute-optimizer", "action": "GetRDSDatabaseRecommendations"},
+			IgnoreConfig: computeOptimizerIgnoreConfig,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource_arn", Require: plugin.Optional},
+				{Name: "instance_finding", Require: plugin.Optional},
+				{Name: "storage_finding", Require: plugin.Optional},
+				{Name: "idle", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(computeoptimizerv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the current DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The engine of the DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The engine version of the DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_finding",
+				Description: "The finding classification of the DB instance class. Possible values are: Optimized, Underprovisioned, Overprovisioned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "storage_finding",
+				Description: "The finding classification of the DB instance storage. Possible values are: Optimized, Underprovisioned, Overprovisioned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "idle",
+				Description: "Indicates whether the DB instance is idle. Possible values are: True, False.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_db_instance_class",
+				Description: "The DB instance class of the current DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentDBInstanceClass"),
+			},
+			{
+				Name:        "recommended_db_instance_class",
+				Description: "The DB instance class of the top ranked instance recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceOption.DbInstanceClass"),
+			},
+			{
+				Name:        "performance_risk",
+				Description: "The performance risk of the top ranked instance recommendation option, from 0 (very low) to 4 (high).",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InstanceOption.PerformanceRisk"),
+			},
+			{
+				Name:        "instance_estimated_monthly_savings_amount",
+				Description: "The estimated monthly savings possible by adopting the top ranked instance recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InstanceOption.SavingsOpportunity.EstimatedMonthlySavings.Value"),
+			},
+			{
+				Name:        "storage_estimated_monthly_savings_amount",
+				Description: "The estimated monthly savings possible by adopting the top ranked storage recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("StorageOption.SavingsOpportunity.EstimatedMonthlySavings.Value"),
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceOption.SavingsOpportunity.EstimatedMonthlySavings.Currency"),
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the DB instance.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("LookbackPeriodInDays"),
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "instance_finding_reason_codes",
+				Description: "The reasons for the finding classification of the DB instance class, such as CPUOverprovisioned or NewGenerationDBInstanceClassAvailable.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "storage_finding_reason_codes",
+				Description: "The reasons for the finding classification of the DB instance storage, such as EBSVolumeAllocatedStorageUnderprovisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "current_storage_configuration",
+				Description: "The storage type, allocated storage, IOPS and throughput of the current DB instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommended_storage_configuration",
+				Description: "The storage type, allocated storage, IOPS and throughput of the top ranked storage recommendation option.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StorageOption.StorageConfiguration"),
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "The utilization metrics of the DB instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instance_recommendation_options",
+				Description: "All the instance recommendation options for the DB instance, ranked by their ability to meet the workload requirements.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "storage_recommendation_options",
+				Description: "All the storage recommendation options for the DB instance, ranked by their ability to meet the workload requirements.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "effective_recommendation_preferences",
+				Description: "The effective recommendation preferences for the DB instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceArn"),
+			},
+		}),
+	}
+}
+
+type computeOptimizerRdsDatabaseRecommendation struct {
+	*types.RDSDBRecommendation
+	InstanceOption *types.RDSDBInstanceRecommendationOption
+	StorageOption  *types.RDSDBStorageRecommendationOption
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerRdsDatabaseRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_rds_database_recommendation.listComputeOptimizerRdsDatabaseRecommendations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &computeoptimizer.GetRDSDatabaseRecommendationsInput{
+		ResourceArns: computeOptimizerArnsInput(d, "resource_arn"),
+		MaxResults:   computeOptimizerLimit(d),
+	}
+	filters := map[string]types.RDSDBRecommendationFilterName{
+		"instance_finding": types.RDSDBRecommendationFilterNameInstanceFinding,
+		"storage_finding":  types.RDSDBRecommendationFilterNameStorageFinding,
+		"idle":             types.RDSDBRecommendationFilterNameIdle,
+	}
+	for column, name := range filters {
+		if value := d.EqualsQualString(column); value != "" {
+			input.Filters = append(input.Filters, types.RDSDBRecommendationFilter{Name: name, Values: []string{value}})
+		}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.GetRDSDatabaseRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_rds_database_recommendation.listComputeOptimizerRdsDatabaseRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for i := range output.RdsDBRecommendations {
+			recommendation := &output.RdsDBRecommendations[i]
+			item := computeOptimizerRdsDatabaseRecommendation{RDSDBRecommendation: recommendation}
+			for j := range recommendation.InstanceRecommendationOptions {
+				option := &recommendation.InstanceRecommendationOptions[j]
+				if item.InstanceOption == nil || option.Rank < item.InstanceOption.Rank {
+					item.InstanceOption = option
+				}
+			}
+			for j := range recommendation.StorageRecommendationOptions {
+				option := &recommendation.StorageRecommendationOptions[j]
+				if item.StorageOption == nil || option.Rank < item.StorageOption.Rank {
+					item.StorageOption = option
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAllocationTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_allocation_tag",
+		Description: "AWS Cost Explorer Cost Allocation Tag",
+		List: &plugin.ListConfig{
+			Hydrate: listCostAllocationTags,
+			Tags:    map[string]string{"service": "ce", "action": "ListCostAllocationTags"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "tag_key", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "tag_key",
+				Description: "The key of the cost allocation tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the cost allocation tag. Only active tags can be used to group and filter costs. Possible values are: Active, Inactive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the cost allocation tag. Possible values are: AWSGenerated, UserDefined.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TagKey"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAllocationTags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_allocation_tag.listCostAllocationTags", "client_error", err)
+		return nil, err
+	}
+
+	// The API returns at most 1000 tags per page
+	maxItems := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	params := &costexplorer.ListCostAllocationTagsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+	if tagKey := d.EqualsQualString("tag_key"); tagKey != "" {
+		params.TagKeys = []string{tagKey}
+	}
+	if status := d.EqualsQualString("status"); status != "" {
+		params.Status = types.CostAllocationTagStatus(status)
+	}
+	if tagType := d.EqualsQualString("type"); tagType != "" {
+		params.Type = types.CostAllocationTagType(tagType)
+	}
+
+	paginator := costexplorer.NewListCostAllocationTagsPaginator(svc, params, func(o *costexplorer.ListCostAllocationTagsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_allocation_tag.listCostAllocationTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.CostAllocationTags {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
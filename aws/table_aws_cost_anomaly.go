@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAnomaly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_anomaly",
+		Description: "AWS Cost Explorer Anomaly",
+		List: &plugin.ListConfig{
+			Hydrate: listCostAnomalies,
+			Tags:    map[string]string{"service": "ce", "action": "GetAnomalies"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "monitor_arn", Require: plugin.Optional},
+				{Name: "feedback", Require: plugin.Optional},
+				{Name: "anomaly_start_date", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+				{Name: "total_impact", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "anomaly_id",
+				Description: "The unique identifier for the anomaly.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "monitor_arn",
+				Description: "The Amazon Resource Name (ARN) for the cost monitor that generated this anomaly.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "anomaly_start_date",
+				Description: "The first day the anomaly is detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "anomaly_end_date",
+				Description: "The last day the anomaly is detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "dimension_value",
+				Description: "The dimension for the anomaly (for example, an Amazon Web Services service in a service monitor).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "feedback",
+				Description: "The feedback value. Possible values are: YES, NO, PLANNED_ACTIVITY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_score",
+				Description: "The last observed score of the anomaly.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AnomalyScore.CurrentScore"),
+			},
+			{
+				Name:        "max_score",
+				Description: "The maximum score that's observed during the anomaly period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AnomalyScore.MaxScore"),
+			},
+			{
+				Name:        "max_impact",
+				Description: "The maximum dollar value that's observed for an anomaly.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Impact.MaxImpact"),
+			},
+			{
+				Name:        "total_impact",
+				Description: "The cumulative dollar difference between the total actual spend and total expected spend.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Impact.TotalImpact"),
+			},
+			{
+				Name:        "total_impact_percentage",
+				Description: "The cumulative percentage difference between the total actual spend and total expected spend.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Impact.TotalImpactPercentage"),
+			},
+			{
+				Name:        "total_actual_spend",
+				Description: "The cumulative dollar amount that was actually spent during the anomaly.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Impact.TotalActualSpend"),
+			},
+			{
+				Name:        "total_expected_spend",
+				Description: "The cumulative dollar amount that was expected to be spent during the anomaly.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Impact.TotalExpectedSpend"),
+			},
+			{
+				Name:        "root_causes",
+				Description: "The list of identified root causes for the anomaly, with their linked account, region, service and usage type.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AnomalyId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAnomalies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly.listCostAnomalies", "client_error", err)
+		return nil, err
+	}
+
+	params := &costexplorer.GetAnomaliesInput{
+		DateInterval: getCostAnomalyDateInterval(d.Quals),
+		TotalImpact:  getCostAnomalyTotalImpactFilter(d.Quals),
+	}
+	if monitorArn := d.EqualsQualString("monitor_arn"); monitorArn != "" {
+		params.MonitorArn = aws.String(monitorArn)
+	}
+	if feedback := d.EqualsQualString("feedback"); feedback != "" {
+		params.Feedback = types.AnomalyFeedbackType(feedback)
+	}
+
+	for {
+		output, err := svc.GetAnomalies(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_anomaly.listCostAnomalies", "api_error", err)
+			return nil, err
+		}
+
+		for _, anomaly := range output.Anomalies {
+			d.StreamListItem(ctx, anomaly)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
+
+// getCostAnomalyDateInterval builds the date interval of the anomalies to
+// retrieve from the anomaly_start_date quals. The API works on whole days, so
+// the bounds are rounded outwards and the exact comparison is left to
+// Steampipe. Without a lower bound, the 90 days before the upper bound (or
+// now), the retention period of cost anomalies, are retrieved.
+func getCostAnomalyDateInterval(quals plugin.KeyColumnQualMap) *types.AnomalyDateInterval {
+	timeFormat := "2006-01-02"
+
+	var start, end time.Time
+	if quals["anomaly_start_date"] != nil {
+		for _, q := range quals["anomaly_start_date"].Quals {
+			t := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				start, end = t, t
+			case ">", ">=":
+				if start.IsZero() || t.After(start) {
+					start = t
+				}
+			case "<", "<=":
+				if end.IsZero() || t.Before(end) {
+					end = t
+				}
+			}
+		}
+	}
+
+	if start.IsZero() {
+		if end.IsZero() {
+			start = time.Now().AddDate(0, 0, -90)
+		} else {
+			start = end.AddDate(0, 0, -90)
+		}
+	}
+
+	interval := &types.AnomalyDateInterval{
+		StartDate: aws.String(start.Format(timeFormat)),
+	}
+	if !end.IsZero() {
+		interval.EndDate = aws.String(end.Format(timeFormat))
+	}
+	return interval
+}
+
+// getCostAnomalyTotalImpactFilter pushes down the total_impact quals. The API
+// takes a single numeric condition, so a lower and an upper bound are sent as
+// an inclusive range and any other combination is left to Steampipe.
+func getCostAnomalyTotalImpactFilter(quals plugin.KeyColumnQualMap) *types.TotalImpactFilter {
+	if quals["total_impact"] == nil {
+		return nil
+	}
+
+	operators := map[string]types.NumericOperator{
+		"=":  types.NumericOperatorEqual,
+		">":  types.NumericOperatorGreaterThan,
+		">=": types.NumericOperatorGreaterThanOrEqual,
+		"<":  types.NumericOperatorLessThan,
+		"<=": types.NumericOperatorLessThanOrEqual,
+	}
+
+	impactQuals := quals["total_impact"].Quals
+	switch len(impactQuals) {
+	case 1:
+		return &types.TotalImpactFilter{
+			NumericOperator: operators[impactQuals[0].Operator],
+			StartValue:      impactQuals[0].Value.GetDoubleValue(),
+		}
+	case 2:
+		var lower, upper *float64
+		for _, q := range impactQuals {
+			value := q.Value.GetDoubleValue()
+			switch q.Operator {
+			case ">", ">=":
+				lower = &value
+			case "<", "<=":
+				upper = &value
+			}
+		}
+		if lower != nil && upper != nil {
+			return &types.TotalImpactFilter{
+				NumericOperator: types.NumericOperatorBetween,
+				StartValue:      *lower,
+				EndValue:        *upper,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAnomalyMonitor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_anomaly_monitor",
+		Description: "AWS Cost Explorer Anomaly Monitor",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			Hydrate:    getCostAnomalyMonitor,
+			Tags:       map[string]string{"service": "ce", "action": "GetAnomalyMonitors"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UnknownMonitorException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostAnomalyMonitors,
+			Tags:    map[string]string{"service": "ce", "action": "GetAnomalyMonitors"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCostAnomalyMonitorTags,
+				Tags: map[string]string{"service": "ce", "action": "ListTagsForResource"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) value of the monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorArn"),
+			},
+			{
+				Name:        "monitor_type",
+				Description: "The possible type values. Possible values are: DIMENSIONAL, CUSTOM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "monitor_dimension",
+				Description: "The dimensions to evaluate for a DIMENSIONAL monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date when the monitor was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_evaluated_date",
+				Description: "The date when the monitor last evaluated for anomalies.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_date",
+				Description: "The date when the monitor was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "dimensional_value_count",
+				Description: "The value for evaluated dimensions.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "monitor_specification",
+				Description: "The filter expression of a CUSTOM monitor.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the monitor.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostAnomalyMonitorTags,
+				Transform:   transform.FromField("ResourceTags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostAnomalyMonitorTags,
+				Transform:   transform.From(costExplorerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MonitorArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAnomalyMonitors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.listCostAnomalyMonitors", "client_error", err)
+		return nil, err
+	}
+
+	params := &costexplorer.GetAnomalyMonitorsInput{}
+
+	for {
+		output, err := svc.GetAnomalyMonitors(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.listCostAnomalyMonitors", "api_error", err)
+			return nil, err
+		}
+
+		for _, monitor := range output.AnomalyMonitors {
+			d.StreamListItem(ctx, monitor)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostAnomalyMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.getCostAnomalyMonitor", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetAnomalyMonitors(ctx, &costexplorer.GetAnomalyMonitorsInput{
+		MonitorArnList: []string{arn},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.getCostAnomalyMonitor", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.AnomalyMonitors) > 0 {
+		return output.AnomalyMonitors[0], nil
+	}
+	return nil, nil
+}
+
+func getCostAnomalyMonitorTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	monitor := h.Item.(types.AnomalyMonitor)
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.getCostAnomalyMonitorTags", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.ListTagsForResource(ctx, &costexplorer.ListTagsForResourceInput{
+		ResourceArn: monitor.MonitorArn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_monitor.getCostAnomalyMonitorTags", "api_error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func costExplorerTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	output := d.HydrateItem.(*costexplorer.ListTagsForResourceOutput)
+
+	if len(output.ResourceTags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, tag := range output.ResourceTags {
+		turbotTagsMap[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAnomalySubscription(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_anomaly_subscription",
+		Description: "AWS Cost Explorer Anomaly Subscription",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			Hydrate:    getCostAnomalySubscription,
+			Tags:       map[string]string{"service": "ce", "action": "GetAnomalySubscriptions"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UnknownSubscriptionException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostAnomalySubscriptions,
+			Tags:    map[string]string{"service": "ce", "action": "GetAnomalySubscriptions"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "monitor_arn", Require: plugin.Optional},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCostAnomalySubscriptionTags,
+				Tags: map[string]string{"service": "ce", "action": "ListTagsForResource"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name for the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The AnomalySubscription Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionArn"),
+			},
+			{
+				Name:        "monitor_arn",
+				Description: "The ARN of a monitor to filter the subscriptions on. Set it in the where clause to list only the subscriptions of that monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("monitor_arn"),
+			},
+			{
+				Name:        "frequency",
+				Description: "The frequency that anomaly notifications are sent. Possible values are: DAILY, IMMEDIATE, WEEKLY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "threshold",
+				Description: "The dollar value that triggers a notification if the threshold is exceeded. Deprecated in favor of threshold_expression.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "subscription_account_id",
+				Description: "The AccountId of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "monitor_arn_list",
+				Description: "A list of cost anomaly monitors.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subscribers",
+				Description: "A list of subscribers to notify.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "threshold_expression",
+				Description: "An expression that specifies the conditions that trigger a notification.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the subscription.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostAnomalySubscriptionTags,
+				Transform:   transform.FromField("ResourceTags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostAnomalySubscriptionTags,
+				Transform:   transform.From(costExplorerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SubscriptionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAnomalySubscriptions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.listCostAnomalySubscriptions", "client_error", err)
+		return nil, err
+	}
+
+	params := &costexplorer.GetAnomalySubscriptionsInput{}
+	if monitorArn := d.EqualsQualString("monitor_arn"); monitorArn != "" {
+		params.MonitorArn = &monitorArn
+	}
+
+	for {
+		output, err := svc.GetAnomalySubscriptions(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.listCostAnomalySubscriptions", "api_error", err)
+			return nil, err
+		}
+
+		for _, subscription := range output.AnomalySubscriptions {
+			d.StreamListItem(ctx, subscription)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostAnomalySubscription(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.EqualsQualString("arn")
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.getCostAnomalySubscription", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.GetAnomalySubscriptions(ctx, &costexplorer.GetAnomalySubscriptionsInput{
+		SubscriptionArnList: []string{arn},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.getCostAnomalySubscription", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.AnomalySubscriptions) > 0 {
+		return output.AnomalySubscriptions[0], nil
+	}
+	return nil, nil
+}
+
+func getCostAnomalySubscriptionTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subscription := h.Item.(types.AnomalySubscription)
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.getCostAnomalySubscriptionTags", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.ListTagsForResource(ctx, &costexplorer.ListTagsForResourceInput{
+		ResourceArn: subscription.SubscriptionArn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_anomaly_subscription.getCostAnomalySubscriptionTags", "api_error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -13,8 +13,9 @@ func tableAwsCostByLinkedAccountDaily(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_account_daily",
 		Description: "AWS Cost Explorer - Cost by Linked Account (Daily)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByLinkedAccountDaily,
-			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			Hydrate:    listCostByLinkedAccountDaily,
+			Tags:       map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			KeyColumns: costExplorerKeyColumns(nil),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
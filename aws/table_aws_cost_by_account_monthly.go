@@ -2,7 +2,6 @@ package aws
 
 import (
 	"context"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -18,8 +17,9 @@ func tableAwsCostByLinkedAccountMonthly(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_account_monthly",
 		Description: "AWS Cost Explorer - Cost by Linked Account (Monthly)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByLinkedAccountMonthly,
-			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			Hydrate:    listCostByLinkedAccountMonthly,
+			Tags:       map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			KeyColumns: costExplorerKeyColumns(nil),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -44,20 +44,8 @@ func listCostByLinkedAccountMonthly(ctx context.Context, d *plugin.QueryData, _
 }
 
 func buildCostByLinkedAccountInput(granularity string) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 		GroupBy: []types.GroupDefinition{
 			{
 				Type: types.GroupDefinitionType("DIMENSION"),
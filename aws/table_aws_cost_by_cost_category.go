@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostByCostCategory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_by_cost_category",
+		Description: "AWS Cost Explorer - Cost By Cost Categories",
+		List: &plugin.ListConfig{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
+				{Name: "granularity", Require: plugin.Required},
+				{Name: "cost_category_name_1", Require: plugin.Required},
+				{Name: "cost_category_name_2", Operators: []string{"=", "<>"}, Require: plugin.Optional, CacheMatch: "exact"},
+			}),
+			Hydrate: listCostAndUsageByCostCategories,
+			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+		},
+		Columns: awsGlobalRegionColumns(
+			costExplorerColumns([]*plugin.Column{
+
+				// Quals columns - to filter the lookups
+				{
+					Name:        "granularity",
+					Description: "The granularity for cost and usage metric data. Possible values are: DAILY|MONTHLY.",
+					Type:        proto.ColumnType_STRING,
+					Hydrate:     hydrateCostAndUsageQuals,
+				},
+				{
+					Name:        "cost_category_name_1",
+					Description: "The name of the cost category to group by.",
+					Type:        proto.ColumnType_STRING,
+					Hydrate:     hydrateCostAndUsageQuals,
+					Transform:   transform.FromField("CostCategoryName1"),
+				},
+				{
+					Name:        "cost_category_value_1",
+					Description: "The primary cost category value grouped by.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("Dimension1").Transform(splitCETagValue),
+				},
+				{
+					Name:        "cost_category_name_2",
+					Description: "A secondary cost category name to group by.",
+					Type:        proto.ColumnType_STRING,
+					Hydrate:     hydrateCostAndUsageQuals,
+					Transform:   transform.FromField("CostCategoryName2"),
+				},
+				{
+					Name:        "cost_category_value_2",
+					Description: "A secondary cost category value grouped by.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("Dimension2").Transform(splitCETagValue),
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAndUsageByCostCategories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	params := buildInputFromCostCategoryQuals(d)
+	return streamCostAndUsage(ctx, d, params)
+}
+
+func buildInputFromCostCategoryQuals(d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	params := &costexplorer.GetCostAndUsageInput{
+		Granularity: types.Granularity(granularity),
+	}
+
+	// Cost Explorer returns the group keys as "<name>$<value>", like tag keys
+	params.GroupBy = []types.GroupDefinition{
+		{
+			Type: types.GroupDefinitionTypeCostCategory,
+			Key:  aws.String(d.EqualsQualString("cost_category_name_1")),
+		},
+	}
+	if name := d.EqualsQualString("cost_category_name_2"); name != "" {
+		params.GroupBy = append(params.GroupBy, types.GroupDefinition{
+			Type: types.GroupDefinitionTypeCostCategory,
+			Key:  aws.String(name),
+		})
+	}
+
+	return params
+}
@@ -13,8 +13,9 @@ func tableAwsCostByRecordTypeDaily(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_record_type_daily",
 		Description: "AWS Cost Explorer - Cost by Record Type (Daily)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByRecordTypeDaily,
-			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			Hydrate:    listCostByRecordTypeDaily,
+			Tags:       map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			KeyColumns: costExplorerKeyColumns(nil),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
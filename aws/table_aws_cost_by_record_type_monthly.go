@@ -2,7 +2,6 @@ package aws
 
 import (
 	"context"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -18,8 +17,9 @@ func tableAwsCostByRecordTypeMonthly(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_record_type_monthly",
 		Description: "AWS Cost Explorer - Cost by Record Type (Monthly)",
 		List: &plugin.ListConfig{
-			Hydrate: listCostByRecordTypeMonthly,
-			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			Hydrate:    listCostByRecordTypeMonthly,
+			Tags:       map[string]string{"service": "ce", "action": "GetCostAndUsage"},
+			KeyColumns: costExplorerKeyColumns(nil),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -50,20 +50,8 @@ func listCostByRecordTypeMonthly(ctx context.Context, d *plugin.QueryData, _ *pl
 }
 
 func buildCostByRecordTypeInput(granularity string) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 		GroupBy: []types.GroupDefinition{
 			{
 				Type: types.GroupDefinitionType("DIMENSION"),
@@ -15,9 +15,9 @@ func tableAwsCostByServiceDaily(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceDaily,
 			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
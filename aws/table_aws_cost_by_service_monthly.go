@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -21,9 +20,9 @@ func tableAwsCostByServiceMonthly(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceMonthly,
 			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -46,20 +45,8 @@ func listCostByServiceMonthly(ctx context.Context, d *plugin.QueryData, _ *plugi
 }
 
 func buildCostByServiceInput(granularity string, d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 		GroupBy: []types.GroupDefinition{
 			{
 				Type: types.GroupDefinitionType("DIMENSION"),
@@ -72,7 +59,7 @@ func buildCostByServiceInput(granularity string, d *plugin.QueryData) *costexplo
 
 	for _, keyQual := range d.Table.List.KeyColumns {
 		filterQual := d.Quals[keyQual.Name]
-		if filterQual == nil {
+		if filterQual == nil || isCostExplorerKeyColumn(keyQual.Name) {
 			continue
 		}
 		for _, qual := range filterQual.Quals {
@@ -15,10 +15,10 @@ func tableAwsCostByServiceUsageTypeDaily(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceAndUsageDaily,
 			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
 				{Name: "usage_type", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -21,10 +20,10 @@ func tableAwsCostByServiceUsageTypeMonthly(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCostByServiceAndUsageMonthly,
 			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
-			KeyColumns: plugin.KeyColumnSlice{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
 				{Name: "service", Operators: []string{"=", "<>"}, Require: plugin.Optional},
 				{Name: "usage_type", Operators: []string{"=", "<>"}, Require: plugin.Optional},
-			},
+			}),
 		},
 		Columns: awsGlobalRegionColumns(
 			costExplorerColumns([]*plugin.Column{
@@ -53,20 +52,8 @@ func listCostByServiceAndUsageMonthly(ctx context.Context, d *plugin.QueryData,
 }
 
 func buildCostByServiceAndUsageInput(granularity string, d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 		GroupBy: []types.GroupDefinition{
 			{
 				Type: types.GroupDefinitionType("DIMENSION"),
@@ -83,7 +70,7 @@ func buildCostByServiceAndUsageInput(granularity string, d *plugin.QueryData) *c
 
 	for _, keyQual := range d.Table.List.KeyColumns {
 		filterQual := d.Quals[keyQual.Name]
-		if filterQual == nil {
+		if filterQual == nil || isCostExplorerKeyColumn(keyQual.Name) {
 			continue
 		}
 		for _, qual := range filterQual.Quals {
@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -20,11 +19,11 @@ func tableAwsCostByTag(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_by_tag",
 		Description: "AWS Cost Explorer - Cost By Tags",
 		List: &plugin.ListConfig{
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: costExplorerKeyColumns(plugin.KeyColumnSlice{
 				{Name: "granularity", Require: plugin.Required},
 				{Name: "tag_key_1", Require: plugin.Required},
 				{Name: "tag_key_2", Operators: []string{"=", "<>"}, Require: plugin.Optional, CacheMatch: "exact"},
-			},
+			}),
 			Hydrate: listCostAndUsageByTags,
 			Tags:    map[string]string{"service": "ce", "action": "GetCostAndUsage"},
 		},
@@ -76,20 +75,8 @@ func listCostAndUsageByTags(ctx context.Context, d *plugin.QueryData, _ *plugin.
 
 func buildInputFromTagKeyAndTagValueQuals(ctx context.Context, d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
 	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 	}
 	tagKey1 := d.EqualsQualString("tag_key_1")
 	tagKey2 := d.EqualsQualString("tag_key_2")
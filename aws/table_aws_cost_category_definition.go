@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostCategoryDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_category_definition",
+		Description: "AWS Cost Explorer Cost Category Definition",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			Hydrate:    getCostCategoryDefinition,
+			Tags:       map[string]string{"service": "ce", "action": "DescribeCostCategoryDefinition"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostCategoryDefinitions,
+			Tags:    map[string]string{"service": "ce", "action": "ListCostCategoryDefinitions"},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getCostCategoryDefinition,
+				Tags: map[string]string{"service": "ce", "action": "DescribeCostCategoryDefinition"},
+			},
+			{
+				Func: getCostCategoryDefinitionTags,
+				Tags: map[string]string{"service": "ce", "action": "ListTagsForResource"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the cost category.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the cost category.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CostCategoryArn"),
+			},
+			{
+				Name:        "effective_start",
+				Description: "The cost category's effective start date.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "effective_end",
+				Description: "The cost category's effective end date. Empty for the current version of the cost category.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "default_value",
+				Description: "The default value assigned to costs that don't match any of the rules.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "number_of_rules",
+				Description: "The number of rules that are associated with the cost category.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "rule_version",
+				Description: "The rule schema version of the cost category.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCostCategoryDefinition,
+			},
+			{
+				Name:        "processing_status",
+				Description: "The processing status of the cost category, per component (e.g. COST_EXPLORER).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "values",
+				Description: "A list of the values of the cost category.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rules",
+				Description: "The rules that map costs to the values of the cost category, in the order they are evaluated.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostCategoryDefinition,
+			},
+			{
+				Name:        "split_charge_rules",
+				Description: "The rules that split the costs of source values across target values of the cost category.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostCategoryDefinition,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the cost category.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostCategoryDefinitionTags,
+				Transform:   transform.FromField("ResourceTags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCostCategoryDefinitionTags,
+				Transform:   transform.From(costExplorerTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CostCategoryArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostCategoryDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_category_definition.listCostCategoryDefinitions", "client_error", err)
+		return nil, err
+	}
+
+	// The API returns at most 100 cost categories per page
+	maxItems := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	params := &costexplorer.ListCostCategoryDefinitionsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := costexplorer.NewListCostCategoryDefinitionsPaginator(svc, params, func(o *costexplorer.ListCostCategoryDefinitionsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_category_definition.listCostCategoryDefinitions", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.CostCategoryReferences {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostCategoryDefinition(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	if h.Item != nil {
+		switch item := h.Item.(type) {
+		case types.CostCategoryReference:
+			arn = aws.ToString(item.CostCategoryArn)
+		case *types.CostCategory:
+			// Already described by the get call
+			return item, nil
+		}
+	} else {
+		arn = d.EqualsQualString("arn")
+	}
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_category_definition.getCostCategoryDefinition", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.DescribeCostCategoryDefinition(ctx, &costexplorer.DescribeCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(arn),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_category_definition.getCostCategoryDefinition", "api_error", err)
+		return nil, err
+	}
+
+	return output.CostCategory, nil
+}
+
+func getCostCategoryDefinitionTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.CostCategoryReference:
+		arn = item.CostCategoryArn
+	case *types.CostCategory:
+		arn = item.CostCategoryArn
+	}
+
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_category_definition.getCostCategoryDefinitionTags", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.ListTagsForResource(ctx, &costexplorer.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_category_definition.getCostCategoryDefinitionTags", "api_error", err)
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostForecast(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_forecast",
+		Description: "AWS Cost Explorer - Cost and Usage Forecast",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "granularity", Require: plugin.Required},
+				{Name: "metric", Require: plugin.Optional},
+				{Name: "period_start", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+				{Name: "period_end", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "prediction_interval_level", Require: plugin.Optional},
+			},
+			Hydrate: listCostForecast,
+			Tags:    map[string]string{"service": "ce", "action": "GetCostForecast"},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "period_start",
+				Description: "Start timestamp for this forecast.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.Start"),
+			},
+			{
+				Name:        "period_end",
+				Description: "End timestamp for this forecast.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.End"),
+			},
+			{
+				Name:        "granularity",
+				Description: "The granularity of the forecast. Possible values are: DAILY|MONTHLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("granularity").TransformP(qualValueOrField, "Granularity"),
+			},
+			{
+				Name:        "metric",
+				Description: "The metric forecasted. Possible values are: AMORTIZED_COST, BLENDED_COST, NET_AMORTIZED_COST, NET_UNBLENDED_COST, NORMALIZED_USAGE_AMOUNT, UNBLENDED_COST, USAGE_QUANTITY. Defaults to UNBLENDED_COST.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("metric").TransformP(qualValueOrField, "Metric"),
+			},
+			{
+				Name:        "mean_value",
+				Description: "The mean value of the forecast.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "prediction_interval_lower_bound",
+				Description: "The lower limit of the prediction interval of the forecast.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "prediction_interval_upper_bound",
+				Description: "The upper limit of the prediction interval of the forecast.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "prediction_interval_level",
+				Description: "The confidence level of the prediction interval, between 51 and 99. Defaults to 80.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "total_amount",
+				Description: "The mean value of the forecast for the whole time period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit",
+				Description: "The unit of the forecast, e.g. USD for cost metrics.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Quals columns - to filter the lookups
+			{
+				Name:        "filter",
+				Description: "The Cost Explorer filter expression of the costs or usage forecasted, e.g. {\"Dimensions\": {\"Key\": \"SERVICE\", \"Values\": [\"Amazon Elastic Compute Cloud - Compute\"]}}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("filter"),
+			},
+		}),
+	}
+}
+
+// costForecastDefaultPredictionIntervalLevel is the confidence level of the
+// prediction interval requested if the prediction_interval_level qual isn't set
+const costForecastDefaultPredictionIntervalLevel = 80
+
+// CostForecastRow is a forecast result with the parameters of the forecast.
+type CostForecastRow struct {
+	TimePeriod *types.DateInterval
+
+	MeanValue                    *string
+	PredictionIntervalLowerBound *string
+	PredictionIntervalUpperBound *string
+	PredictionIntervalLevel      int32
+
+	Granularity string
+	Metric      string
+	TotalAmount *string
+	Unit        *string
+}
+
+//// LIST FUNCTION
+
+func listCostForecast(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Get client
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_forecast.listCostForecast", "client_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	metric := ceForecastMetric(ceQualOrDefault(d, "metric", string(types.MetricUnblendedCost)))
+
+	predictionIntervalLevel := int32(costForecastDefaultPredictionIntervalLevel)
+	if d.EqualsQuals["prediction_interval_level"] != nil {
+		predictionIntervalLevel = int32(d.EqualsQuals["prediction_interval_level"].GetInt64Value())
+	}
+
+	filter, err := getCEFilterQual(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_forecast.listCostForecast", "qual_error", err)
+		return nil, err
+	}
+
+	timePeriod := getCEForecastDateInterval(d.Quals, granularity, time.Now())
+	if *timePeriod.Start >= *timePeriod.End {
+		return nil, nil
+	}
+
+	// Usage metrics are forecasted by a different API
+	var results []types.ForecastResult
+	var total *types.MetricValue
+	switch metric {
+	case types.MetricUsageQuantity, types.MetricNormalizedUsageAmount:
+		output, err := svc.GetUsageForecast(ctx, &costexplorer.GetUsageForecastInput{
+			Granularity:             types.Granularity(granularity),
+			Metric:                  metric,
+			TimePeriod:              timePeriod,
+			Filter:                  filter,
+			PredictionIntervalLevel: aws.Int32(predictionIntervalLevel),
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_forecast.listCostForecast", "api_error", err)
+			return nil, err
+		}
+		results, total = output.ForecastResultsByTime, output.Total
+	default:
+		output, err := svc.GetCostForecast(ctx, &costexplorer.GetCostForecastInput{
+			Granularity:             types.Granularity(granularity),
+			Metric:                  metric,
+			TimePeriod:              timePeriod,
+			Filter:                  filter,
+			PredictionIntervalLevel: aws.Int32(predictionIntervalLevel),
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_forecast.listCostForecast", "api_error", err)
+			return nil, err
+		}
+		results, total = output.ForecastResultsByTime, output.Total
+	}
+
+	// stream the results...
+	for _, r := range results {
+		row := CostForecastRow{
+			TimePeriod:                   r.TimePeriod,
+			MeanValue:                    r.MeanValue,
+			PredictionIntervalLowerBound: r.PredictionIntervalLowerBound,
+			PredictionIntervalUpperBound: r.PredictionIntervalUpperBound,
+			PredictionIntervalLevel:      predictionIntervalLevel,
+			Granularity:                  granularity,
+			Metric:                       string(metric),
+		}
+		if total != nil {
+			row.TotalAmount = total.Amount
+			row.Unit = total.Unit
+		}
+		d.StreamListItem(ctx, row)
+
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ceForecastMetric returns the forecast metric of the metric qual, which may
+// be given either as a forecast metric, e.g. UNBLENDED_COST, or as a cost and
+// usage metric, e.g. UnblendedCost.
+func ceForecastMetric(metric string) types.Metric {
+	if strings.Contains(metric, "_") || strings.ToUpper(metric) == metric {
+		return types.Metric(strings.ToUpper(metric))
+	}
+
+	var b strings.Builder
+	for i, r := range metric {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return types.Metric(b.String())
+}
+
+// getCEForecastDateInterval returns the time period to forecast, narrowed by
+// the period_start and period_end quals. Forecasts start today at the
+// earliest, and end by default after the longest period supported for the
+// granularity.
+func getCEForecastDateInterval(quals plugin.KeyColumnQualMap, granularity string, now time.Time) *types.DateInterval {
+	timeFormat := "2006-01-02"
+
+	start, end := getCEQualTimeBounds(quals, granularity)
+	today := now.UTC().Truncate(24 * time.Hour)
+	if start.Before(today) {
+		start = today
+	}
+	if end.IsZero() {
+		end = getForecastEndDateForGranularity(granularity)
+	}
+
+	return &types.DateInterval{
+		Start: aws.String(start.UTC().Format(timeFormat)),
+		End:   aws.String(end.UTC().Format(timeFormat)),
+	}
+}
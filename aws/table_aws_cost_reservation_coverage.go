@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostReservationCoverage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_reservation_coverage",
+		Description: "AWS Cost Explorer - Reservation Coverage",
+		List: &plugin.ListConfig{
+			Hydrate:    listCostReservationCoverage,
+			Tags:       map[string]string{"service": "ce", "action": "GetReservationCoverage"},
+			KeyColumns: costExplorerPeriodKeyColumns(),
+		},
+		Columns: awsGlobalRegionColumns(
+			costExplorerPeriodColumns([]*plugin.Column{
+				{
+					Name:        "coverage_hours_percentage",
+					Description: "The percentage of instance hours that a reservation covered.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageHours.CoverageHoursPercentage"),
+				},
+				{
+					Name:        "on_demand_hours",
+					Description: "The number of instance running hours that On-Demand Instances covered.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageHours.OnDemandHours"),
+				},
+				{
+					Name:        "reserved_hours",
+					Description: "The number of instance running hours that reservations covered.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageHours.ReservedHours"),
+				},
+				{
+					Name:        "total_running_hours",
+					Description: "The total instance usage, in hours.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageHours.TotalRunningHours"),
+				},
+				{
+					Name:        "on_demand_cost",
+					Description: "How much an On-Demand Instance costs.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageCost.OnDemandCost"),
+				},
+				{
+					Name:        "coverage_normalized_units_percentage",
+					Description: "The percentage of your used instance normalized units that a reservation covers.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageNormalizedUnits.CoverageNormalizedUnitsPercentage"),
+				},
+				{
+					Name:        "on_demand_normalized_units",
+					Description: "The number of normalized units that are covered by On-Demand Instances instead of a reservation.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageNormalizedUnits.OnDemandNormalizedUnits"),
+				},
+				{
+					Name:        "reserved_normalized_units",
+					Description: "The number of normalized units that a reservation covers.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageNormalizedUnits.ReservedNormalizedUnits"),
+				},
+				{
+					Name:        "total_running_normalized_units",
+					Description: "The total number of normalized units that you used.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.CoverageNormalizedUnits.TotalRunningNormalizedUnits"),
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostReservationCoverage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_reservation_coverage.listCostReservationCoverage", "client_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	params := &costexplorer.GetReservationCoverageInput{
+		TimePeriod:  getCEDateInterval(d.Quals, granularity),
+		Granularity: types.Granularity(granularity),
+	}
+
+	// List call
+	for {
+		output, err := svc.GetReservationCoverage(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_reservation_coverage.listCostReservationCoverage", "api_error", err)
+			return nil, err
+		}
+
+		for _, coverage := range output.CoveragesByTime {
+			d.StreamListItem(ctx, coverage)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableAwsCostReservationPurchaseRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_reservation_purchase_recommendation",
+		Description: "AWS Cost Explorer - Reservation Purchase Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listCostReservationPurchaseRecommendations,
+			Tags:    map[string]string{"service": "ce", "action": "GetReservationPurchaseRecommendation"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "service", Require: plugin.Required},
+				{Name: "term_in_years", Require: plugin.Optional},
+				{Name: "payment_option", Require: plugin.Optional},
+				{Name: "lookback_period_in_days", Require: plugin.Optional},
+				{Name: "account_scope", Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "recommendation_id",
+				Description: "The ID for the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service",
+				Description: "The specific service that you want recommendations for, e.g. Amazon Elastic Compute Cloud - Compute, Amazon Relational Database Service, Amazon ElastiCache, Amazon Redshift, Amazon OpenSearch Service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "term_in_years",
+				Description: "The term of the reservation that you want recommendations for. Possible values are: ONE_YEAR, THREE_YEARS. Defaults to ONE_YEAR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The reservation purchase option that you want recommendations for. Possible values are: NO_UPFRONT, PARTIAL_UPFRONT, ALL_UPFRONT. Defaults to NO_UPFRONT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lookback_period_in_days",
+				Description: "The number of previous days that you want Amazon Web Services to consider when it calculates your recommendations. Possible values are: SEVEN_DAYS, THIRTY_DAYS, SIXTY_DAYS. Defaults to THIRTY_DAYS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_scope",
+				Description: "The account scope that you want your recommendations for. Possible values are: PAYER, LINKED. Defaults to PAYER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: "The account that this recommendation is for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code that Amazon Web Services used to calculate the costs for this instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommended_number_of_instances_to_purchase",
+				Description: "The number of instances that Amazon Web Services recommends that you purchase.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recommended_normalized_units_to_purchase",
+				Description: "The number of normalized units that Amazon Web Services recommends that you purchase.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "upfront_cost",
+				Description: "How much purchasing this instance costs you upfront.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_standard_monthly_cost",
+				Description: "How much purchasing this instance costs you on a monthly basis.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_break_even_in_months",
+				Description: "How long Amazon Web Services estimates that it takes for this instance to start saving you money, in months.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_on_demand_cost",
+				Description: "How much Amazon Web Services estimates that you spend on On-Demand Instances in a month.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_amount",
+				Description: "How much Amazon Web Services estimates that this specific recommendation might save you in a month.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_percentage",
+				Description: "How much Amazon Web Services estimates that this specific recommendation might save you in a month, as a percentage of your overall costs.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_reservation_cost_for_lookback_period",
+				Description: "How much Amazon Web Services estimates that you might spend on all of the instances that are recommended for you to purchase in a lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "average_utilization",
+				Description: "The average utilization of your instances.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "average_number_of_instances_used_per_hour",
+				Description: "The average number of instances that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "maximum_number_of_instances_used_per_hour",
+				Description: "The maximum number of instances that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "minimum_number_of_instances_used_per_hour",
+				Description: "The minimum number of instances that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "average_normalized_units_used_per_hour",
+				Description: "The average number of normalized units that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "maximum_normalized_units_used_per_hour",
+				Description: "The maximum number of normalized units that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "minimum_normalized_units_used_per_hour",
+				Description: "The minimum number of normalized units that you used in an hour during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "generation_timestamp",
+				Description: "The timestamp for when Amazon Web Services made the recommendation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "instance_details",
+				Description: "Details about the instances that Amazon Web Services recommends that you purchase.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+type reservationPurchaseRecommendation struct {
+	types.ReservationPurchaseRecommendationDetail
+	RecommendationId     *string
+	GenerationTimestamp  *string
+	Service              *string
+	TermInYears          types.TermInYears
+	PaymentOption        types.PaymentOption
+	LookbackPeriodInDays types.LookbackPeriodInDays
+	AccountScope         types.AccountScope
+}
+
+//// LIST FUNCTION
+
+func listCostReservationPurchaseRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_reservation_purchase_recommendation.listCostReservationPurchaseRecommendations", "client_error", err)
+		return nil, err
+	}
+
+	params := &costexplorer.GetReservationPurchaseRecommendationInput{
+		Service:              aws.String(d.EqualsQualString("service")),
+		TermInYears:          types.TermInYears(ceQualOrDefault(d, "term_in_years", string(types.TermInYearsOneYear))),
+		PaymentOption:        types.PaymentOption(ceQualOrDefault(d, "payment_option", string(types.PaymentOptionNoUpfront))),
+		LookbackPeriodInDays: types.LookbackPeriodInDays(ceQualOrDefault(d, "lookback_period_in_days", string(types.LookbackPeriodInDaysThirtyDays))),
+		AccountScope:         types.AccountScope(ceQualOrDefault(d, "account_scope", string(types.AccountScopePayer))),
+	}
+
+	// List call
+	for {
+		output, err := svc.GetReservationPurchaseRecommendation(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_reservation_purchase_recommendation.listCostReservationPurchaseRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, recommendation := range output.Recommendations {
+			for _, detail := range recommendation.RecommendationDetails {
+				item := reservationPurchaseRecommendation{
+					ReservationPurchaseRecommendationDetail: detail,
+					Service:                                 params.Service,
+					TermInYears:                             recommendation.TermInYears,
+					PaymentOption:                           recommendation.PaymentOption,
+					LookbackPeriodInDays:                    recommendation.LookbackPeriodInDays,
+					AccountScope:                            recommendation.AccountScope,
+				}
+				if output.Metadata != nil {
+					item.RecommendationId = output.Metadata.RecommendationId
+					item.GenerationTimestamp = output.Metadata.GenerationTimestamp
+				}
+				d.StreamListItem(ctx, item)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
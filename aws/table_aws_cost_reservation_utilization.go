@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostReservationUtilization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_reservation_utilization",
+		Description: "AWS Cost Explorer - Reservation Utilization",
+		List: &plugin.ListConfig{
+			Hydrate:    listCostReservationUtilization,
+			Tags:       map[string]string{"service": "ce", "action": "GetReservationUtilization"},
+			KeyColumns: costExplorerPeriodKeyColumns(),
+		},
+		Columns: awsGlobalRegionColumns(
+			costExplorerPeriodColumns([]*plugin.Column{
+				{
+					Name:        "utilization_percentage",
+					Description: "The percentage of reservation time that you used.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.UtilizationPercentage"),
+				},
+				{
+					Name:        "purchased_hours",
+					Description: "How many reservation hours that you purchased.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.PurchasedHours"),
+				},
+				{
+					Name:        "total_actual_hours",
+					Description: "The total number of reservation hours that you used.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.TotalActualHours"),
+				},
+				{
+					Name:        "unused_hours",
+					Description: "The number of reservation hours that you didn't use.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.UnusedHours"),
+				},
+				{
+					Name:        "purchased_units",
+					Description: "The number of Amazon EC2 reservation hours that you purchased, converted to normalized units.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.PurchasedUnits"),
+				},
+				{
+					Name:        "total_actual_units",
+					Description: "The total number of Amazon EC2 reservation hours that you used, converted to normalized units.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.TotalActualUnits"),
+				},
+				{
+					Name:        "unused_units",
+					Description: "The number of Amazon EC2 reservation hours that you didn't use, converted to normalized units.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.UnusedUnits"),
+				},
+				{
+					Name:        "on_demand_cost_of_ri_hours_used",
+					Description: "How much your reservation costs if charged On-Demand rates.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.OnDemandCostOfRIHoursUsed"),
+				},
+				{
+					Name:        "net_ri_savings",
+					Description: "How much you saved due to purchasing and utilizing reservation, net of the reservation fees.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.NetRISavings"),
+				},
+				{
+					Name:        "total_potential_ri_savings",
+					Description: "How much you might save if you use your entire reservation.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.TotalPotentialRISavings"),
+				},
+				{
+					Name:        "amortized_recurring_fee",
+					Description: "The monthly cost of your reservation, amortized over the reservation period.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.AmortizedRecurringFee"),
+				},
+				{
+					Name:        "amortized_upfront_fee",
+					Description: "The upfront cost of your reservation, amortized over the reservation period.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.AmortizedUpfrontFee"),
+				},
+				{
+					Name:        "total_amortized_fee",
+					Description: "The total cost of your reservation, amortized over the reservation period.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.TotalAmortizedFee"),
+				},
+				{
+					Name:        "ri_cost_for_unused_hours",
+					Description: "The unused cost of your reservation.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.RICostForUnusedHours"),
+				},
+				{
+					Name:        "realized_savings",
+					Description: "The realized savings because of purchasing and using a reservation.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.RealizedSavings"),
+				},
+				{
+					Name:        "unrealized_savings",
+					Description: "The unrealized savings because of purchasing and using a reservation.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Total.UnrealizedSavings"),
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostReservationUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_reservation_utilization.listCostReservationUtilization", "client_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	params := &costexplorer.GetReservationUtilizationInput{
+		TimePeriod:  getCEDateInterval(d.Quals, granularity),
+		Granularity: types.Granularity(granularity),
+	}
+
+	// List call
+	for {
+		output, err := svc.GetReservationUtilization(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_reservation_utilization.listCostReservationUtilization", "api_error", err)
+			return nil, err
+		}
+
+		for _, utilization := range output.UtilizationsByTime {
+			d.StreamListItem(ctx, utilization)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
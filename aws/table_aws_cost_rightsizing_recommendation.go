@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostRightsizingRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_rightsizing_recommendation",
+		Description: "AWS Cost Explorer - Rightsizing Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listCostRightsizingRecommendations,
+			Tags:    map[string]string{"service": "ce", "action": "GetRightsizingRecommendation"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "recommendation_target", Require: plugin.Optional},
+				{Name: "benefits_considered", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the current EC2 instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentInstance.ResourceId"),
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the current EC2 instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentInstance.InstanceName"),
+			},
+			{
+				Name:        "linked_account_id",
+				Description: "The account that the recommendation is for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "rightsizing_type",
+				Description: "A recommendation to either terminate or modify the resource. Possible values are: TERMINATE, MODIFY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The list of possible reasons why the recommendation is generated, such as under or over utilization of specific metrics.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "current_instance_type",
+				Description: "The instance type of the current EC2 instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentInstance.ResourceDetails.EC2ResourceDetails.InstanceType"),
+			},
+			{
+				Name:        "current_monthly_cost",
+				Description: "The current On-Demand cost of operating the instance on a monthly basis.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CurrentInstance.MonthlyCost"),
+			},
+			{
+				Name:        "recommended_instance_type",
+				Description: "The instance type of the default target instance of a MODIFY recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetInstance.ResourceDetails.EC2ResourceDetails.InstanceType"),
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The expected cost of operating the default target instance on a monthly basis.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("TargetInstance.EstimatedMonthlyCost"),
+			},
+			{
+				Name:        "estimated_monthly_savings",
+				Description: "The estimated monthly savings of terminating the instance, or of modifying it to the default target instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code that Amazon Web Services used to calculate the costs for this instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentInstance.CurrencyCode"),
+			},
+			{
+				Name:        "total_running_hours_in_lookback_period",
+				Description: "The total number of hours that the instance ran during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CurrentInstance.TotalRunningHoursInLookbackPeriod"),
+			},
+			{
+				Name:        "recommendation_target",
+				Description: "The option to see recommendations within the same instance family or across instance families. Possible values are: SAME_INSTANCE_FAMILY, CROSS_INSTANCE_FAMILY. Defaults to SAME_INSTANCE_FAMILY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "benefits_considered",
+				Description: "Whether the recommendation considers RI and Savings Plans discounts. Defaults to true.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "lookback_period_in_days",
+				Description: "The number of days of previous usage that Amazon Web Services considers when making the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "generation_timestamp",
+				Description: "The timestamp for when Amazon Web Services made the recommendation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "recommendation_id",
+				Description: "The ID for the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_utilization",
+				Description: "The utilization of the current instance during the lookback period.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CurrentInstance.ResourceUtilization"),
+			},
+			{
+				Name:        "expected_utilization",
+				Description: "The expected utilization of the default target instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TargetInstance.ExpectedResourceUtilization"),
+			},
+			{
+				Name:        "target_instances",
+				Description: "All the target instances of a MODIFY recommendation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifyRecommendationDetail.TargetInstances"),
+			},
+			{
+				Name:        "current_instance",
+				Description: "The details of the current instance, such as its resource details, costs and covered hours.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentInstance.ResourceId"),
+			},
+		}),
+	}
+}
+
+type costRightsizingRecommendation struct {
+	types.RightsizingRecommendation
+	TargetInstance          *types.TargetInstance
+	EstimatedMonthlySavings *string
+	RecommendationTarget    types.RecommendationTarget
+	BenefitsConsidered      bool
+	LookbackPeriodInDays    types.LookbackPeriodInDays
+	GenerationTimestamp     *string
+	RecommendationId        *string
+}
+
+//// LIST FUNCTION
+
+func listCostRightsizingRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_rightsizing_recommendation.listCostRightsizingRecommendations", "client_error", err)
+		return nil, err
+	}
+
+	configuration := &types.RightsizingRecommendationConfiguration{
+		RecommendationTarget: types.RecommendationTarget(ceQualOrDefault(d, "recommendation_target", string(types.RecommendationTargetSameInstanceFamily))),
+		BenefitsConsidered:   true,
+	}
+	if d.Quals["benefits_considered"] != nil {
+		for _, q := range d.Quals["benefits_considered"].Quals {
+			value := q.Value.GetBoolValue()
+			if q.Operator == "<>" {
+				value = !value
+			}
+			configuration.BenefitsConsidered = value
+		}
+	}
+
+	// Rightsizing recommendations are only supported for Amazon EC2
+	params := &costexplorer.GetRightsizingRecommendationInput{
+		Service:       aws.String("AmazonEC2"),
+		Configuration: configuration,
+	}
+
+	for {
+		output, err := svc.GetRightsizingRecommendation(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_rightsizing_recommendation.listCostRightsizingRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, recommendation := range output.RightsizingRecommendations {
+			item := costRightsizingRecommendation{
+				RightsizingRecommendation: recommendation,
+				RecommendationTarget:      configuration.RecommendationTarget,
+				BenefitsConsidered:        configuration.BenefitsConsidered,
+			}
+			if output.Metadata != nil {
+				item.LookbackPeriodInDays = output.Metadata.LookbackPeriodInDays
+				item.GenerationTimestamp = output.Metadata.GenerationTimestamp
+				item.RecommendationId = output.Metadata.RecommendationId
+			}
+			if recommendation.TerminateRecommendationDetail != nil {
+				item.EstimatedMonthlySavings = recommendation.TerminateRecommendationDetail.EstimatedMonthlySavings
+			}
+			if recommendation.ModifyRecommendationDetail != nil {
+				for i, target := range recommendation.ModifyRecommendationDetail.TargetInstances {
+					if i == 0 || target.DefaultTargetInstance {
+						item.TargetInstance = &recommendation.ModifyRecommendationDetail.TargetInstances[i]
+						item.EstimatedMonthlySavings = target.EstimatedMonthlySavings
+					}
+				}
+			}
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostSavingsPlansCoverage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_savings_plans_coverage",
+		Description: "AWS Cost Explorer - Savings Plans Coverage",
+		List: &plugin.ListConfig{
+			Hydrate:    listCostSavingsPlansCoverage,
+			Tags:       map[string]string{"service": "ce", "action": "GetSavingsPlansCoverage"},
+			KeyColumns: costExplorerPeriodKeyColumns(),
+		},
+		Columns: awsGlobalRegionColumns(
+			costExplorerPeriodColumns([]*plugin.Column{
+				{
+					Name:        "coverage_percentage",
+					Description: "The percentage of your existing Savings Plans covered usage, divided by all of your eligible Savings Plans usage in an account (or set of accounts).",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Coverage.CoveragePercentage"),
+				},
+				{
+					Name:        "on_demand_cost",
+					Description: "The cost of your Amazon Web Services usage at the public On-Demand rate.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Coverage.OnDemandCost"),
+				},
+				{
+					Name:        "spend_covered_by_savings_plans",
+					Description: "The amount of your Amazon Web Services usage that's covered by a Savings Plans.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Coverage.SpendCoveredBySavingsPlans"),
+				},
+				{
+					Name:        "total_cost",
+					Description: "The total cost of your Amazon Web Services usage, regardless of your purchase option.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Coverage.TotalCost"),
+				},
+				{
+					Name:        "attributes",
+					Description: "The attribute that applies to a specific Dimension.",
+					Type:        proto.ColumnType_JSON,
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostSavingsPlansCoverage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_savings_plans_coverage.listCostSavingsPlansCoverage", "client_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	params := &costexplorer.GetSavingsPlansCoverageInput{
+		TimePeriod:  getCEDateInterval(d.Quals, granularity),
+		Granularity: types.Granularity(granularity),
+	}
+
+	// List call
+	for {
+		output, err := svc.GetSavingsPlansCoverage(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_savings_plans_coverage.listCostSavingsPlansCoverage", "api_error", err)
+			return nil, err
+		}
+
+		for _, coverage := range output.SavingsPlansCoverages {
+			d.StreamListItem(ctx, coverage)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextToken == nil {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
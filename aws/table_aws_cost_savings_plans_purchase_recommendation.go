@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostSavingsPlansPurchaseRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_savings_plans_purchase_recommendation",
+		Description: "AWS Cost Explorer - Savings Plans Purchase Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listCostSavingsPlansPurchaseRecommendations,
+			Tags:    map[string]string{"service": "ce", "action": "GetSavingsPlansPurchaseRecommendation"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "savings_plans_type", Require: plugin.Optional},
+				{Name: "term_in_years", Require: plugin.Optional},
+				{Name: "payment_option", Require: plugin.Optional},
+				{Name: "lookback_period_in_days", Require: plugin.Optional},
+				{Name: "account_scope", Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "recommendation_id",
+				Description: "The unique identifier for the recommendation set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plans_type",
+				Description: "The requested Savings Plans recommendation type. Possible values are: COMPUTE_SP, EC2_INSTANCE_SP, SAGEMAKER_SP. Defaults to COMPUTE_SP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "term_in_years",
+				Description: "The Savings Plans recommendation term in years. Possible values are: ONE_YEAR, THREE_YEARS. Defaults to ONE_YEAR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The payment option that's used to generate the recommendation. Possible values are: NO_UPFRONT, PARTIAL_UPFRONT, ALL_UPFRONT. Defaults to NO_UPFRONT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lookback_period_in_days",
+				Description: "The lookback period in days that's used to generate the recommendation. Possible values are: SEVEN_DAYS, THIRTY_DAYS, SIXTY_DAYS. Defaults to THIRTY_DAYS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_scope",
+				Description: "The account scope that you want your recommendations for. Possible values are: PAYER, LINKED. Defaults to PAYER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_id",
+				Description: "The AccountID the recommendation is generated for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code that Amazon Web Services used to generate the recommendations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hourly_commitment_to_purchase",
+				Description: "The recommended hourly commitment level for the Savings Plans type and the configuration that's based on the usage during the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "upfront_cost",
+				Description: "The upfront cost of the recommended Savings Plans, based on the selected payment option.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_average_utilization",
+				Description: "The estimated utilization of the recommended Savings Plans.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_amount",
+				Description: "The estimated monthly savings amount based on the recommended Savings Plans.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_on_demand_cost",
+				Description: "The remaining On-Demand cost estimated to not be covered by the recommended Savings Plans, over the length of the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_on_demand_cost_with_current_commitment",
+				Description: "The estimated On-Demand costs you expect with no additional commitment, based on your usage of the selected time period and the Savings Plans you own.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_roi",
+				Description: "The estimated return on investment that's based on the recommended Savings Plans that you purchased.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("EstimatedROI"),
+			},
+			{
+				Name:        "estimated_sp_cost",
+				Description: "The cost of the recommended Savings Plans over the length of the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("EstimatedSPCost"),
+			},
+			{
+				Name:        "estimated_savings_amount",
+				Description: "The estimated savings amount that's based on the recommended Savings Plans over the length of the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_savings_percentage",
+				Description: "The estimated savings percentage relative to the total cost of applicable On-Demand usage over the lookback period.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "current_average_hourly_on_demand_spend",
+				Description: "The average value of hourly On-Demand spend over the lookback period of the applicable usage type.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "current_maximum_hourly_on_demand_spend",
+				Description: "The highest value of hourly On-Demand spend over the lookback period of the applicable usage type.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "current_minimum_hourly_on_demand_spend",
+				Description: "The lowest value of hourly On-Demand spend over the lookback period of the applicable usage type.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "generation_timestamp",
+				Description: "The timestamp that shows when the recommendations were generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "savings_plans_details",
+				Description: "Details for the Savings Plans that are recommended, such as the instance family, offering ID and region.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+type savingsPlansPurchaseRecommendation struct {
+	types.SavingsPlansPurchaseRecommendationDetail
+	RecommendationId     *string
+	GenerationTimestamp  *string
+	SavingsPlansType     types.SupportedSavingsPlansType
+	TermInYears          types.TermInYears
+	PaymentOption        types.PaymentOption
+	LookbackPeriodInDays types.LookbackPeriodInDays
+	AccountScope         types.AccountScope
+}
+
+//// LIST FUNCTION
+
+func listCostSavingsPlansPurchaseRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_savings_plans_purchase_recommendation.listCostSavingsPlansPurchaseRecommendations", "client_error", err)
+		return nil, err
+	}
+
+	// The API requires the recommendation parameters, so fall back to the
+	// defaults of the Cost Explorer console when they aren't in the quals
+	params := &costexplorer.GetSavingsPlansPurchaseRecommendationInput{
+		SavingsPlansType:     types.SupportedSavingsPlansType(ceQualOrDefault(d, "savings_plans_type", string(types.SupportedSavingsPlansTypeComputeSp))),
+		TermInYears:          types.TermInYears(ceQualOrDefault(d, "term_in_years", string(types.TermInYearsOneYear))),
+		PaymentOption:        types.PaymentOption(ceQualOrDefault(d, "payment_option", string(types.PaymentOptionNoUpfront))),
+		LookbackPeriodInDays: types.LookbackPeriodInDays(ceQualOrDefault(d, "lookback_period_in_days", string(types.LookbackPeriodInDaysThirtyDays))),
+		AccountScope:         types.AccountScope(ceQualOrDefault(d, "account_scope", string(types.AccountScopePayer))),
+	}
+
+	// List call
+	for {
+		output, err := svc.GetSavingsPlansPurchaseRecommendation(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_savings_plans_purchase_recommendation.listCostSavingsPlansPurchaseRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		if output.SavingsPlansPurchaseRecommendation != nil {
+			for _, detail := range output.SavingsPlansPurchaseRecommendation.SavingsPlansPurchaseRecommendationDetails {
+				item := savingsPlansPurchaseRecommendation{
+					SavingsPlansPurchaseRecommendationDetail: detail,
+					SavingsPlansType:                         params.SavingsPlansType,
+					TermInYears:                              params.TermInYears,
+					PaymentOption:                            params.PaymentOption,
+					LookbackPeriodInDays:                     params.LookbackPeriodInDays,
+					AccountScope:                             params.AccountScope,
+				}
+				if output.Metadata != nil {
+					item.RecommendationId = output.Metadata.RecommendationId
+					item.GenerationTimestamp = output.Metadata.GenerationTimestamp
+				}
+				d.StreamListItem(ctx, item)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		// get more pages if there are any...
+		if output.NextPageToken == nil {
+			break
+		}
+		params.NextPageToken = output.NextPageToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsCostSavingsPlansUtilization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_savings_plans_utilization",
+		Description: "AWS Cost Explorer - Savings Plans Utilization",
+		List: &plugin.ListConfig{
+			Hydrate:    listCostSavingsPlansUtilization,
+			Tags:       map[string]string{"service": "ce", "action": "GetSavingsPlansUtilization"},
+			KeyColumns: costExplorerPeriodKeyColumns(),
+			// Accounts without any Savings Plans return a DataUnavailableException
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DataUnavailableException"}),
+			},
+		},
+		Columns: awsGlobalRegionColumns(
+			costExplorerPeriodColumns([]*plugin.Column{
+				{
+					Name:        "total_commitment",
+					Description: "The total amount of Savings Plans commitment that's been purchased in an account (or set of accounts).",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Utilization.TotalCommitment"),
+				},
+				{
+					Name:        "used_commitment",
+					Description: "The amount of your Savings Plans commitment that was consumed from Savings Plans eligible usage in a specific period.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Utilization.UsedCommitment"),
+				},
+				{
+					Name:        "unused_commitment",
+					Description: "The amount of your Savings Plans commitment that wasn't consumed from Savings Plans eligible usage in a specific period.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Utilization.UnusedCommitment"),
+				},
+				{
+					Name:        "utilization_percentage",
+					Description: "The amount of UsedCommitment divided by the TotalCommitment for your Savings Plans.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Utilization.UtilizationPercentage"),
+				},
+				{
+					Name:        "net_savings",
+					Description: "The savings amount that you're accumulating for the usage that's covered by a Savings Plans, when compared to the On-Demand equivalent of the same usage.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Savings.NetSavings"),
+				},
+				{
+					Name:        "on_demand_cost_equivalent",
+					Description: "How much the amount that the usage would have cost if it was accrued at the On-Demand rate.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("Savings.OnDemandCostEquivalent"),
+				},
+				{
+					Name:        "amortized_recurring_commitment",
+					Description: "The amortized amount of your Savings Plans commitment that was purchased with either a Partial or a NoUpfront.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("AmortizedCommitment.AmortizedRecurringCommitment"),
+				},
+				{
+					Name:        "amortized_upfront_commitment",
+					Description: "The amortized amount of your Savings Plans commitment that was purchased with an Upfront or PartialUpfront Savings Plans.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("AmortizedCommitment.AmortizedUpfrontCommitment"),
+				},
+				{
+					Name:        "total_amortized_commitment",
+					Description: "The total amortized amount of your Savings Plans commitment, regardless of your Savings Plans purchase method.",
+					Type:        proto.ColumnType_DOUBLE,
+					Transform:   transform.FromField("AmortizedCommitment.TotalAmortizedCommitment"),
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostSavingsPlansUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_savings_plans_utilization.listCostSavingsPlansUtilization", "client_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.EqualsQualString("granularity"))
+	params := &costexplorer.GetSavingsPlansUtilizationInput{
+		TimePeriod:  getCEDateInterval(d.Quals, granularity),
+		Granularity: types.Granularity(granularity),
+	}
+
+	output, err := svc.GetSavingsPlansUtilization(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_savings_plans_utilization.listCostSavingsPlansUtilization", "api_error", err)
+		return nil, err
+	}
+
+	for _, utilization := range output.SavingsPlansUtilizationsByTime {
+		d.StreamListItem(ctx, utilization)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
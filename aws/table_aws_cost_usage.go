@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
@@ -18,8 +17,7 @@ func tableAwsCostAndUsage(_ context.Context) *plugin.Table {
 		Name:        "aws_cost_usage",
 		Description: "AWS Cost Explorer - Cost and Usage",
 		List: &plugin.ListConfig{
-			//KeyColumns: plugin.AllColumns([]string{"search_start_time", "search_end_time", "granularity", "dimension_type_1", "dimension_type_2"}),
-			KeyColumns: plugin.AllColumns([]string{"granularity", "dimension_type_1", "dimension_type_2"}),
+			KeyColumns: costExplorerKeyColumns(plugin.AllColumns([]string{"granularity", "dimension_type_1", "dimension_type_2"})),
 			Hydrate:    listCostAndUsage,
 			Tags:       map[string]string{"service": "ce", "action": "GetCostAndUsage"},
 		},
@@ -115,23 +113,11 @@ func listCostAndUsage(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 
 func buildInputFromQuals(keyQuals map[string]*proto.QualValue) *costexplorer.GetCostAndUsageInput {
 	granularity := strings.ToUpper(keyQuals["granularity"].GetStringValue())
-	timeFormat := "2006-01-02"
-	if granularity == "HOURLY" {
-		timeFormat = "2006-01-02T15:04:05Z"
-	}
-	endTime := time.Now().Format(timeFormat)
-	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
-
 	dim1 := strings.ToUpper(keyQuals["dimension_type_1"].GetStringValue())
 	dim2 := strings.ToUpper(keyQuals["dimension_type_2"].GetStringValue())
 
 	params := &costexplorer.GetCostAndUsageInput{
-		TimePeriod: &types.DateInterval{
-			Start: aws.String(startTime),
-			End:   aws.String(endTime),
-		},
 		Granularity: types.Granularity(granularity),
-		Metrics:     AllCostMetrics(),
 	}
 	var groupings []types.GroupDefinition
 	if dim1 != "" {
@@ -0,0 +1,837 @@
+package aws
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	exportTypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
+	curTypes "github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostUsageReportLineItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_usage_report_line_item",
+		Description: "AWS Cost and Usage Report line items, read from the report files delivered to S3.",
+		List: &plugin.ListConfig{
+			Hydrate: listCostUsageReportLineItems,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "report_name", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "billing_period", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "s3_bucket", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "s3_prefix", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "report_name",
+				Description: "The name of the report, or of the data export for CUR 2.0.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_period",
+				Description: "The start of the billing period (month) of the line item. Defaults to the current month. A billing_period qual selects the billing period it falls in, in UTC, and is returned as given.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("billing_period").TransformP(qualValueOrField, "BillingPeriod"),
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The bucket the report is delivered to. Set it along with report_name and s3_prefix to read a report that isn't discovered through the Cost and Usage Report or Data Exports APIs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_prefix",
+				Description: "The prefix of the report in the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_file_key",
+				Description: "The key of the report data file the line item was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_item_id",
+				Description: "The ID of the line item, unique within the billing period.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_item_type",
+				Description: "The type of charge of the line item, e.g. Usage, DiscountedUsage, SavingsPlanCoveredUsage, RIFee, Fee, Tax or Credit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_item_description",
+				Description: "The description of the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bill_payer_account_id",
+				Description: "The ID of the account paying the bill, i.e. the management account of the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_account_id",
+				Description: "The ID of the account that used the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_start_date",
+				Description: "The start of the usage period of the line item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "usage_end_date",
+				Description: "The end of the usage period of the line item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "product_code",
+				Description: "The code of the product measured, e.g. AmazonEC2.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_region",
+				Description: "The region of the product, e.g. us-east-1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_type",
+				Description: "The usage type of the line item, e.g. USW2-BoxUsage:m5.large.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The operation of the line item, e.g. RunInstances.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The availability zone of the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource used, e.g. an instance ID or bucket name. Only set if the report includes resource IDs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_amount",
+				Description: "The amount of usage of the line item.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "pricing_unit",
+				Description: "The unit of the usage amount, e.g. Hrs or GB-Mo.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pricing_term",
+				Description: "Whether the usage is Reserved or OnDemand.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the costs of the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unblended_rate",
+				Description: "The rate applied to the usage of the line item.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unblended_cost",
+				Description: "The cost of the line item, i.e. the unblended rate multiplied by the usage amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "blended_cost",
+				Description: "The cost of the line item at the blended rate of the organization.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "net_unblended_cost",
+				Description: "The cost of the line item after discounts. Only set if the account has discounts.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "amortized_cost",
+				Description: "The effective cost of the line item, with upfront and recurring Savings Plans and Reserved Instances fees spread over the usage they cover, as in the Cost Explorer amortized cost.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "savings_plan_arn",
+				Description: "The ARN of the Savings Plan covering the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_rate",
+				Description: "The Savings Plan rate applied to the usage of the line item.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "savings_plan_effective_cost",
+				Description: "The proportion of the Savings Plan commitment, including upfront fees, allocated to the line item.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "reservation_arn",
+				Description: "The ARN of the Reserved Instance covering the line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reservation_effective_cost",
+				Description: "The upfront and hourly fees of the Reserved Instance allocated to the line item.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "tags",
+				Description: "The cost allocation tags of the resource of the line item.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "fields",
+				Description: "All the columns of the line item, with their names as in the Parquet reports, e.g. line_item_unblended_cost.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LineItemId"),
+			},
+		}),
+	}
+}
+
+// curLineItem is a row of a Cost and Usage Report. The line item columns are
+// named after the Parquet report columns, e.g. line_item_unblended_cost, which
+// are the legacy CSV report columns in snake case, e.g. lineItem/UnblendedCost.
+type curLineItem struct {
+	ReportName               *string
+	BillingPeriod            *time.Time
+	S3Bucket                 *string
+	S3Prefix                 *string
+	DataFileKey              *string
+	LineItemId               *string
+	LineItemType             *string
+	LineItemDescription      *string
+	BillPayerAccountId       *string
+	UsageAccountId           *string
+	UsageStartDate           *time.Time
+	UsageEndDate             *time.Time
+	ProductCode              *string
+	ProductRegion            *string
+	UsageType                *string
+	Operation                *string
+	AvailabilityZone         *string
+	ResourceId               *string
+	UsageAmount              *float64
+	PricingUnit              *string
+	PricingTerm              *string
+	CurrencyCode             *string
+	UnblendedRate            *float64
+	UnblendedCost            *float64
+	BlendedCost              *float64
+	NetUnblendedCost         *float64
+	AmortizedCost            *float64
+	SavingsPlanArn           *string
+	SavingsPlanRate          *float64
+	SavingsPlanEffectiveCost *float64
+	ReservationArn           *string
+	ReservationEffectiveCost *float64
+	Tags                     map[string]string
+	Fields                   map[string]interface{}
+}
+
+// curReport is the location of a report in S3.
+type curReport struct {
+	Name   string
+	Bucket string
+	Prefix string
+}
+
+// curManifest is the manifest delivered with each report. Legacy reports list
+// their data file keys in reportKeys, while CUR 2.0 exports list their data
+// file URIs in dataFiles.
+type curManifest struct {
+	ReportKeys []string `json:"reportKeys"`
+	DataFiles  []string `json:"dataFiles"`
+}
+
+//// LIST FUNCTION
+
+func listCostUsageReportLineItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	reports, err := getCostUsageReports(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportLineItems", "api_error", err)
+		return nil, err
+	}
+
+	// Reports are delivered per billing period, i.e. per calendar month
+	period := time.Now().UTC()
+	if d.EqualsQuals["billing_period"] != nil {
+		period = d.EqualsQuals["billing_period"].GetTimestampValue().AsTime().UTC()
+	}
+	period = time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for _, report := range reports {
+		more, err := readCostUsageReport(ctx, d, h, report, period)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportLineItems", "report_name", report.Name, "api_error", err)
+			return nil, err
+		}
+		if !more {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getCostUsageReports returns the report given by the s3_bucket, s3_prefix and
+// report_name quals, or the legacy reports and CUR 2.0 exports of the account
+// matching the report_name qual, if any.
+func getCostUsageReports(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) ([]curReport, error) {
+	reportName := d.EqualsQualString("report_name")
+
+	if bucket := d.EqualsQualString("s3_bucket"); bucket != "" {
+		if reportName == "" {
+			return nil, fmt.Errorf("report_name must be specified along with s3_bucket")
+		}
+		return []curReport{{Name: reportName, Bucket: bucket, Prefix: d.EqualsQualString("s3_prefix")}}, nil
+	}
+
+	definitions, err := listCostUsageReportDefinitions(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []curReport
+	for _, definition := range definitions {
+		if reportName != "" && *definition.ReportName != reportName {
+			continue
+		}
+		reports = append(reports, curReport{
+			Name:   *definition.ReportName,
+			Bucket: *definition.S3Bucket,
+			Prefix: *definition.S3Prefix,
+		})
+	}
+
+	exports, err := listCostUsageReportExports(ctx, d, reportName)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reports, exports...), nil
+}
+
+// readCostUsageReport streams the line items of the report for the billing
+// period. It returns false once no more rows are needed.
+func readCostUsageReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, report curReport, period time.Time) (bool, error) {
+	svc, err := s3ClientForBucket(ctx, d, h, report.Bucket)
+	if err != nil {
+		return false, err
+	}
+
+	keys, err := getCostUsageReportDataFiles(ctx, d, svc, report, period)
+	if err != nil {
+		return false, err
+	}
+	if len(keys) == 0 {
+		plugin.Logger(ctx).Debug("aws_cost_usage_report_line_item.readCostUsageReport", "report_name", report.Name, "no report found for billing period", period)
+		return true, nil
+	}
+
+	for _, key := range keys {
+		stream := func(fields map[string]interface{}) bool {
+			item := newCURLineItem(fields)
+			item.ReportName = aws.String(report.Name)
+			item.BillingPeriod = aws.Time(period)
+			item.S3Bucket = aws.String(report.Bucket)
+			item.S3Prefix = aws.String(report.Prefix)
+			item.DataFileKey = aws.String(key)
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.RowsRemaining(ctx) != 0
+		}
+
+		var more bool
+		switch {
+		case strings.HasSuffix(key, ".parquet"):
+			more, err = readCURParquetFile(ctx, svc, report.Bucket, key, stream)
+		case strings.HasSuffix(key, ".csv.gz"), strings.HasSuffix(key, ".csv"):
+			more, err = readCURCsvFile(ctx, svc, report.Bucket, key, stream)
+		default:
+			return false, fmt.Errorf("report %s data file %s is not supported, only CSV (GZIP compressed) and Parquet reports are supported", report.Name, key)
+		}
+		if err != nil || !more {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// getCostUsageReportDataFiles returns the keys of the data files of the
+// report for the billing period, from the manifest of the latest report
+// delivered for the period. Reports are delivered to:
+//   - <prefix>/<name>/metadata/BILLING_PERIOD=2023-10/.../<name>-Manifest.json for CUR 2.0 exports
+//   - <prefix>/<name>/20231001-20231101/<name>-Manifest.json for legacy reports
+//   - <prefix>/<name>/<name>/year=2023/month=10/*.parquet for legacy Parquet reports,
+//     which may have no manifest
+func getCostUsageReportDataFiles(ctx context.Context, d *plugin.QueryData, svc *s3.Client, report curReport, period time.Time) ([]string, error) {
+	base := curReportBasePrefix(report.Prefix, report.Name)
+
+	// CUR 2.0 export
+	manifestKey, err := latestCURManifestKey(ctx, d, svc, report.Bucket, base+"metadata/BILLING_PERIOD="+period.Format("2006-01")+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	// Legacy report
+	if manifestKey == "" {
+		manifestKey = base + period.Format("20060102") + "-" + period.AddDate(0, 1, 0).Format("20060102") + "/" + report.Name + "-Manifest.json"
+	}
+
+	manifest, err := getCURManifest(ctx, svc, report.Bucket, manifestKey)
+	if err != nil {
+		return nil, err
+	}
+	if manifest != nil {
+		keys := manifest.ReportKeys
+		for _, uri := range manifest.DataFiles {
+			keys = append(keys, strings.TrimPrefix(uri, "s3://"+report.Bucket+"/"))
+		}
+		return keys, nil
+	}
+
+	// Legacy Parquet report without a manifest
+	var keys []string
+	prefix := fmt.Sprintf("%s%s/year=%d/month=%d/", base, report.Name, period.Year(), int(period.Month()))
+	err = listCURObjects(ctx, d, svc, report.Bucket, prefix, func(object types.Object) {
+		if strings.HasSuffix(*object.Key, ".parquet") {
+			keys = append(keys, *object.Key)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// latestCURManifestKey returns the key of the latest manifest under the
+// prefix, or an empty string if there is none.
+func latestCURManifestKey(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string, prefix string) (string, error) {
+	var latest types.Object
+	err := listCURObjects(ctx, d, svc, bucketName, prefix, func(object types.Object) {
+		if strings.HasSuffix(*object.Key, "-Manifest.json") && (latest.LastModified == nil || object.LastModified.After(*latest.LastModified)) {
+			latest = object
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(latest.Key), nil
+}
+
+func listCURObjects(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string, prefix string, fn func(object types.Object)) error {
+	paginator := s3.NewListObjectsV2Paginator(svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, object := range output.Contents {
+			fn(object)
+		}
+	}
+	return nil
+}
+
+// getCURManifest returns the manifest, or nil if it doesn't exist.
+func getCURManifest(ctx context.Context, svc *s3.Client, bucketName string, key string) (*curManifest, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	manifest := &curManifest{}
+	if err := json.NewDecoder(object.Body).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse report manifest %s: %v", key, err)
+	}
+	return manifest, nil
+}
+
+// readCURCsvFile reads a CSV data file, optionally GZIP compressed, whose
+// first line is the header, e.g. identity/LineItemId,identity/TimeInterval,...
+func readCURCsvFile(ctx context.Context, svc *s3.Client, bucketName string, key string, fn func(fields map[string]interface{}) bool) (bool, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer object.Body.Close()
+
+	reader, err := newS3ObjectContentReader(object.Body)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.ReuseRecord = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	schema := make([]string, len(header))
+	for i, column := range header {
+		schema[i] = curColumnName(column)
+	}
+
+	for {
+		values, err := csvReader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		fields := map[string]interface{}{}
+		for i, value := range values {
+			if i < len(schema) && value != "" {
+				fields[schema[i]] = value
+			}
+		}
+
+		if !fn(fields) {
+			return false, nil
+		}
+	}
+}
+
+// readCURParquetFile reads a Parquet data file with ranged requests. Manifests
+// do not list the size of the data files, so it is read first.
+func readCURParquetFile(ctx context.Context, svc *s3.Client, bucketName string, key string, fn func(fields map[string]interface{}) bool) (bool, error) {
+	object, err := svc.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return readS3ParquetFile(ctx, svc, bucketName, key, object.ContentLength, fn)
+}
+
+// listCostUsageReportDefinitions returns the legacy report definitions of the
+// account. CUR 2.0 exports are managed by the Data Exports API instead.
+func listCostUsageReportDefinitions(ctx context.Context, d *plugin.QueryData) ([]curTypes.ReportDefinition, error) {
+	// Create session
+	svc, err := CostAndUsageReportClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportDefinitions", "client_error", err)
+		return nil, err
+	}
+
+	var definitions []curTypes.ReportDefinition
+	paginator := costandusagereportservice.NewDescribeReportDefinitionsPaginator(svc, &costandusagereportservice.DescribeReportDefinitionsInput{}, func(o *costandusagereportservice.DescribeReportDefinitionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportDefinitions", "api_error", err)
+			return nil, err
+		}
+		definitions = append(definitions, output.ReportDefinitions...)
+	}
+
+	return definitions, nil
+}
+
+// listCostUsageReportExports returns the CUR 2.0 exports of the account, i.e.
+// the data exports of the COST_AND_USAGE_REPORT table, with the given name if
+// set. Without access to the Data Exports API, e.g. in partitions it isn't
+// available in or without the bcm-data-exports:ListExports permission, no
+// exports are returned, and they can only be read by setting s3_bucket.
+func listCostUsageReportExports(ctx context.Context, d *plugin.QueryData, exportName string) ([]curReport, error) {
+	// Create session
+	svc, err := BcmDataExportsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportExports", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported partition
+		return nil, nil
+	}
+
+	var reports []curReport
+	paginator := bcmdataexports.NewListExportsPaginator(svc, &bcmdataexports.ListExportsInput{}, func(o *bcmdataexports.ListExportsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			if shouldIgnoreErrors([]string{"AccessDeniedException"})(ctx, d, nil, err) {
+				plugin.Logger(ctx).Warn("aws_cost_usage_report_line_item.listCostUsageReportExports", "CUR 2.0 exports are not discovered", err)
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportExports", "api_error", err)
+			return nil, err
+		}
+
+		for _, reference := range output.Exports {
+			if exportName != "" && aws.ToString(reference.ExportName) != exportName {
+				continue
+			}
+
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			export, err := svc.GetExport(ctx, &bcmdataexports.GetExportInput{ExportArn: reference.ExportArn})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_cost_usage_report_line_item.listCostUsageReportExports", "api_error", err)
+				return nil, err
+			}
+			if report, ok := curExportReport(export.Export); ok {
+				reports = append(reports, report)
+			}
+		}
+	}
+
+	return reports, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// curExportReport returns the location of a data export in S3, if it is a CUR
+// 2.0 export, i.e. its query selects from the COST_AND_USAGE_REPORT table.
+func curExportReport(export *exportTypes.Export) (curReport, bool) {
+	if export == nil || export.DataQuery == nil || export.DestinationConfigurations == nil || export.DestinationConfigurations.S3Destination == nil {
+		return curReport{}, false
+	}
+	if !strings.Contains(strings.ToUpper(aws.ToString(export.DataQuery.QueryStatement)), "FROM COST_AND_USAGE_REPORT") {
+		return curReport{}, false
+	}
+
+	destination := export.DestinationConfigurations.S3Destination
+	return curReport{
+		Name:   aws.ToString(export.Name),
+		Bucket: aws.ToString(destination.S3Bucket),
+		Prefix: aws.ToString(destination.S3Prefix),
+	}, true
+}
+
+// curReportBasePrefix returns the folder reports are delivered to, i.e.
+// <prefix>/<name>/, with or without a trailing slash in the prefix.
+func curReportBasePrefix(prefix string, name string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return name + "/"
+	}
+	return prefix + "/" + name + "/"
+}
+
+// curColumnName maps a legacy CSV report column, e.g. lineItem/UnblendedCost,
+// to its Parquet report name, e.g. line_item_unblended_cost. Each upper case
+// letter starts a new word, so reservation/ReservationARN maps to
+// reservation_reservation_a_r_n as in the Parquet reports. Tag keys are kept
+// as is, e.g. resourceTags/user:Name maps to resource_tags_user:Name.
+func curColumnName(column string) string {
+	category, name, found := strings.Cut(column, "/")
+	if !found {
+		return curSnakeCase(column)
+	}
+	if category == "resourceTags" {
+		return "resource_tags_" + name
+	}
+	return curSnakeCase(category) + "_" + curSnakeCase(name)
+}
+
+func curSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// newCURLineItem builds a row from the fields of a line item. CSV reports
+// contain only strings, while Parquet reports contain typed values.
+// CUR 2.0 exports name the product region column product_region_code.
+func newCURLineItem(fields map[string]interface{}) *curLineItem {
+	item := &curLineItem{
+		LineItemId:               s3InventoryString(fields["identity_line_item_id"]),
+		LineItemType:             s3InventoryString(fields["line_item_line_item_type"]),
+		LineItemDescription:      s3InventoryString(fields["line_item_line_item_description"]),
+		BillPayerAccountId:       s3InventoryString(fields["bill_payer_account_id"]),
+		UsageAccountId:           s3InventoryString(fields["line_item_usage_account_id"]),
+		UsageStartDate:           s3InventoryTime(fields["line_item_usage_start_date"]),
+		UsageEndDate:             s3InventoryTime(fields["line_item_usage_end_date"]),
+		ProductCode:              s3InventoryString(fields["line_item_product_code"]),
+		ProductRegion:            s3InventoryString(fields["product_region"]),
+		UsageType:                s3InventoryString(fields["line_item_usage_type"]),
+		Operation:                s3InventoryString(fields["line_item_operation"]),
+		AvailabilityZone:         s3InventoryString(fields["line_item_availability_zone"]),
+		ResourceId:               s3InventoryString(fields["line_item_resource_id"]),
+		UsageAmount:              curFloat64(fields["line_item_usage_amount"]),
+		PricingUnit:              s3InventoryString(fields["pricing_unit"]),
+		PricingTerm:              s3InventoryString(fields["pricing_term"]),
+		CurrencyCode:             s3InventoryString(fields["line_item_currency_code"]),
+		UnblendedRate:            curFloat64(fields["line_item_unblended_rate"]),
+		UnblendedCost:            curFloat64(fields["line_item_unblended_cost"]),
+		BlendedCost:              curFloat64(fields["line_item_blended_cost"]),
+		NetUnblendedCost:         curFloat64(fields["line_item_net_unblended_cost"]),
+		SavingsPlanArn:           s3InventoryString(fields["savings_plan_savings_plan_a_r_n"]),
+		SavingsPlanRate:          curFloat64(fields["savings_plan_savings_plan_rate"]),
+		SavingsPlanEffectiveCost: curFloat64(fields["savings_plan_savings_plan_effective_cost"]),
+		ReservationArn:           s3InventoryString(fields["reservation_reservation_a_r_n"]),
+		ReservationEffectiveCost: curFloat64(fields["reservation_effective_cost"]),
+		Tags:                     curTags(fields),
+		Fields:                   fields,
+	}
+	if item.ProductRegion == nil {
+		item.ProductRegion = s3InventoryString(fields["product_region_code"])
+	}
+	item.AmortizedCost = curAmortizedCost(item, fields)
+
+	return item
+}
+
+// curAmortizedCost returns the amortized cost of a line item, as computed by
+// Cost Explorer: Savings Plans and Reserved Instances fees are replaced by
+// their share allocated to the usage they cover.
+// https://docs.aws.amazon.com/cur/latest/userguide/amortized-costs.html
+func curAmortizedCost(item *curLineItem, fields map[string]interface{}) *float64 {
+	value := func(field string) float64 {
+		if v := curFloat64(fields[field]); v != nil {
+			return *v
+		}
+		return 0
+	}
+
+	switch aws.ToString(item.LineItemType) {
+	case "SavingsPlanCoveredUsage":
+		return aws.Float64(value("savings_plan_savings_plan_effective_cost"))
+	case "SavingsPlanRecurringFee":
+		return aws.Float64(value("savings_plan_total_commitment_to_date") - value("savings_plan_used_commitment"))
+	case "SavingsPlanNegation", "SavingsPlanUpfrontFee":
+		return aws.Float64(0)
+	case "DiscountedUsage":
+		return aws.Float64(value("reservation_effective_cost"))
+	case "RIFee":
+		return aws.Float64(value("reservation_unused_amortized_upfront_fee_for_billing_period") + value("reservation_unused_recurring_fee"))
+	case "Fee":
+		// Upfront fees of Reserved Instances are amortized by the RIFee line items
+		if item.ReservationArn != nil {
+			return aws.Float64(0)
+		}
+	}
+	return item.UnblendedCost
+}
+
+// curTags returns the resource tags of a line item. Legacy reports have a
+// column per tag key, e.g. resource_tags_user_name, while CUR 2.0 exports have
+// a single resource_tags map column.
+func curTags(fields map[string]interface{}) map[string]string {
+	tags := map[string]string{}
+	for name, value := range fields {
+		if key, found := strings.CutPrefix(name, "resource_tags_"); found {
+			if v := s3InventoryString(value); v != nil {
+				tags[key] = *v
+			}
+		}
+	}
+
+	var resourceTags map[string]interface{}
+	switch v := fields["resource_tags"].(type) {
+	case map[string]interface{}:
+		resourceTags = v
+	case string:
+		_ = json.Unmarshal([]byte(v), &resourceTags)
+	}
+	for key, value := range resourceTags {
+		if v := s3InventoryString(value); v != nil {
+			tags[key] = *v
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+func curFloat64(value interface{}) *float64 {
+	switch v := value.(type) {
+	case float64:
+		return aws.Float64(v)
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return aws.Float64(f)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	exportTypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+)
+
+func TestCURColumnName(t *testing.T) {
+	cases := map[string]string{
+		"identity/LineItemId":           "identity_line_item_id",
+		"lineItem/UnblendedCost":        "line_item_unblended_cost",
+		"reservation/ReservationARN":    "reservation_reservation_a_r_n",
+		"savingsPlan/SavingsPlanARN":    "savings_plan_savings_plan_a_r_n",
+		"resourceTags/user:Cost Center": "resource_tags_user:Cost Center",
+		"line_item_usage_type":          "line_item_usage_type",
+	}
+	for column, expected := range cases {
+		if name := curColumnName(column); name != expected {
+			t.Errorf("%s: expected %s, got %s", column, expected, name)
+		}
+	}
+}
+
+func TestNewCURLineItem(t *testing.T) {
+	cases := []struct {
+		name      string
+		fields    map[string]interface{}
+		amortized float64
+		tags      map[string]string
+	}{
+		{"usage", map[string]interface{}{
+			"line_item_line_item_type": "Usage",
+			"line_item_unblended_cost": "1.5",
+			"resource_tags_user:Name":  "web",
+		}, 1.5, map[string]string{"user:Name": "web"}},
+		{"savings plan covered usage", map[string]interface{}{
+			"line_item_line_item_type":                 "SavingsPlanCoveredUsage",
+			"line_item_unblended_cost":                 1.5,
+			"savings_plan_savings_plan_effective_cost": 0.9,
+			"resource_tags":                            map[string]interface{}{"user_team": "data"},
+		}, 0.9, map[string]string{"user_team": "data"}},
+		{"savings plan recurring fee", map[string]interface{}{
+			"line_item_line_item_type":              "SavingsPlanRecurringFee",
+			"line_item_unblended_cost":              "10",
+			"savings_plan_total_commitment_to_date": "10",
+			"savings_plan_used_commitment":          "8",
+		}, 2, nil},
+		{"reservation upfront fee", map[string]interface{}{
+			"line_item_line_item_type":      "Fee",
+			"line_item_unblended_cost":      "1000",
+			"reservation_reservation_a_r_n": "arn:aws:ec2:us-east-1:123456789012:reserved-instances/abc",
+		}, 0, nil},
+	}
+	for _, c := range cases {
+		item := newCURLineItem(c.fields)
+		if item.AmortizedCost == nil || *item.AmortizedCost != c.amortized {
+			t.Errorf("%s: expected amortized cost %v, got %v", c.name, c.amortized, item.AmortizedCost)
+		}
+		if !reflect.DeepEqual(item.Tags, c.tags) {
+			t.Errorf("%s: expected tags %v, got %v", c.name, c.tags, item.Tags)
+		}
+	}
+}
+
+func TestCURExportReport(t *testing.T) {
+	destination := &exportTypes.DestinationConfigurations{
+		S3Destination: &exportTypes.S3Destination{S3Bucket: aws.String("my-billing-bucket"), S3Prefix: aws.String("exports")},
+	}
+	export := &exportTypes.Export{
+		Name:                      aws.String("my-export"),
+		DataQuery:                 &exportTypes.DataQuery{QueryStatement: aws.String("SELECT line_item_unblended_cost FROM COST_AND_USAGE_REPORT")},
+		DestinationConfigurations: destination,
+	}
+	report, ok := curExportReport(export)
+	if !ok || report != (curReport{Name: "my-export", Bucket: "my-billing-bucket", Prefix: "exports"}) {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	export.DataQuery.QueryStatement = aws.String("SELECT * FROM CARBON_EMISSIONS")
+	if _, ok := curExportReport(export); ok {
+		t.Error("expected exports of other tables to be skipped")
+	}
+}
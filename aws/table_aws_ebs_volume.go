@@ -54,6 +54,11 @@ func tableAwsEBSVolume(_ context.Context) *plugin.Table {
 				Func: getVolumeProductCodes,
 				Tags: map[string]string{"service": "ec2", "action": "DescribeVolumeAttribute"},
 			},
+			{
+				Func:         getEBSVolumeEstimatedCost,
+				Tags:         map[string]string{"service": "pricing", "action": "GetProducts"},
+				IgnoreConfig: pricingIndexIgnoreConfig,
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -147,6 +152,18 @@ func tableAwsEBSVolume(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getVolumeProductCodes,
 			},
+			{
+				Name:        "estimated_hourly_cost",
+				Description: "The estimated hourly cost of the volume, i.e. the estimated monthly cost divided by 730.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeEstimatedCost,
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The estimated monthly cost of the volume, by volume type, size, provisioned IOPS and throughput and region, from the public price list. Null if the price is unknown. I/O requests of magnetic volumes are not included.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeEstimatedCost,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the volume.",
@@ -320,6 +337,19 @@ func getVolumeProductCodes(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return volumeAttributes, nil
 }
 
+func getEBSVolumeEstimatedCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	volume := h.Item.(types.Volume)
+
+	index, err := getPricingIndex(ctx, d, "AmazonEC2", region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ebs_volume.getEBSVolumeEstimatedCost", "pricing_index_error", err)
+		return nil, err
+	}
+
+	return estimateEBSVolumeCost(index, string(volume.VolumeType), aws.ToInt32(volume.Size), aws.ToInt32(volume.Iops), aws.ToInt32(volume.Throughput)), nil
+}
+
 func getEBSVolumeARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	region := d.EqualsQualString(matrixKeyRegion)
 	volume := h.Item.(types.Volume)
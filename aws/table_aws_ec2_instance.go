@@ -89,6 +89,11 @@ func tableAwsEc2Instance(_ context.Context) *plugin.Table {
 				Func: getInstanceStatus,
 				Tags: map[string]string{"service": "ec2", "action": "DescribeInstanceStatus"},
 			},
+			{
+				Func:         getEc2InstanceEstimatedCost,
+				Tags:         map[string]string{"service": "pricing", "action": "GetProducts"},
+				IgnoreConfig: pricingIndexIgnoreConfig,
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -477,6 +482,18 @@ func tableAwsEc2Instance(_ context.Context) *plugin.Table {
 				Hydrate:     getInstanceStatus,
 				Transform:   transform.FromField("InstanceStatuses[0]"),
 			},
+			{
+				Name:        "estimated_hourly_cost",
+				Description: "The estimated on-demand hourly cost of the instance, by instance type, tenancy, platform and region, from the public price list. Zero if the instance isn't running, and null for Spot instances, instances on Dedicated Hosts and unknown prices. Savings Plans and Reserved Instances are not taken into account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEc2InstanceEstimatedCost,
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The estimated on-demand monthly cost of the instance, i.e. 730 times the estimated hourly cost.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEc2InstanceEstimatedCost,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the instance.",
@@ -628,6 +645,27 @@ func getEc2InstanceARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	return arn, nil
 }
 
+func getEc2InstanceEstimatedCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(types.Instance)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	index, err := getPricingIndex(ctx, d, "AmazonEC2", region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_instance.getEc2InstanceEstimatedCost", "pricing_index_error", err)
+		return nil, err
+	}
+
+	var tenancy, state string
+	if instance.Placement != nil {
+		tenancy = string(instance.Placement.Tenancy)
+	}
+	if instance.State != nil {
+		state = string(instance.State.Name)
+	}
+
+	return estimateEc2InstanceCost(index, string(instance.InstanceType), tenancy, aws.ToString(instance.PlatformDetails), string(instance.InstanceLifecycle), state), nil
+}
+
 func getInstanceDisableAPITerminationData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	instance := h.Item.(types.Instance)
 
@@ -0,0 +1,399 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsEksClusterAuditEventListKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		// CloudWatch fields
+		{Name: "cluster_name", Require: plugin.Optional},
+		{Name: "log_stream_name", Require: plugin.Optional},
+		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+		{Name: "region", Require: plugin.Optional},
+		{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+
+		// event fields
+		{Name: "verb", Require: plugin.Optional},
+		{Name: "username", Require: plugin.Optional},
+		{Name: "impersonated_username", Require: plugin.Optional},
+		{Name: "namespace", Require: plugin.Optional},
+		{Name: "resource", Require: plugin.Optional},
+		{Name: "subresource", Require: plugin.Optional},
+		{Name: "object_name", Require: plugin.Optional},
+		{Name: "response_code", Require: plugin.Optional},
+		{Name: "stage", Require: plugin.Optional},
+	}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsEksClusterAuditEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_eks_cluster_audit_event",
+		Description: "AWS EKS cluster Kubernetes API server audit events from CloudWatch Logs.",
+		List: &plugin.ListConfig{
+			Hydrate:    listEksClusterAuditEvents,
+			Tags:       map[string]string{"service": "logs", "action": "FilterLogEvents"},
+			KeyColumns: tableAwsEksClusterAuditEventListKeyColumns(),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(eksv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			// Top columns
+			{Name: "cluster_name", Type: proto.ColumnType_STRING, Description: "The name of the cluster the audit event was recorded for."},
+			{Name: "log_group_name", Type: proto.ColumnType_STRING, Description: "The name of the log group to which this event belongs."},
+			{Name: "log_stream_name", Type: proto.ColumnType_STRING, Description: "The name of the log stream to which this event belongs."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp), Description: "The time when the event occurred."},
+
+			// Kubernetes audit event fields
+			{Name: "audit_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.AuditID"), Description: "Unique audit ID, generated for each request."},
+			{Name: "level", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.Level"), Description: "The audit level at which the event was generated, such as Metadata, Request or RequestResponse."},
+			{Name: "stage", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.Stage"), Description: "The stage of the request handling when this event instance was generated, such as ResponseComplete."},
+			{Name: "verb", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.Verb"), Description: "The Kubernetes verb associated with the request, such as get, list, create or delete."},
+			{Name: "request_uri", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.RequestURI"), Description: "The request URI as sent by the client to the API server."},
+			{Name: "username", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.User.Username"), Description: "The name of the authenticated user that made the request."},
+			{Name: "user_uid", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.User.UID"), Description: "The unique ID of the authenticated user that made the request."},
+			{Name: "user_groups", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.User.Groups"), Description: "The groups the authenticated user belongs to."},
+			{Name: "user_extra", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.User.Extra"), Description: "Any additional information provided by the authenticator, such as the IAM ARN the user authenticated with."},
+			{Name: "impersonated_username", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ImpersonatedUser.Username"), Description: "The name of the user that was impersonated, if any."},
+			{Name: "impersonated_user", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.ImpersonatedUser"), Description: "Information about the impersonated user, if any."},
+			{Name: "source_ips", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.SourceIPs"), Description: "The source IPs the request originated from and any intermediate proxies."},
+			{Name: "user_agent", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.UserAgent"), Description: "The user agent string reported by the client."},
+			{Name: "api_group", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.APIGroup"), Description: "The API group of the object the request was for."},
+			{Name: "api_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.APIVersion"), Description: "The API version of the object the request was for."},
+			{Name: "resource", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.Resource"), Description: "The resource type of the object the request was for, such as pods or secrets."},
+			{Name: "subresource", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.Subresource"), Description: "The subresource the request was for, such as exec or log."},
+			{Name: "namespace", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.Namespace"), Description: "The namespace of the object the request was for."},
+			{Name: "object_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.ObjectRef.Name"), Description: "The name of the object the request was for."},
+			{Name: "object_ref", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.ObjectRef"), Description: "The object reference the request was targeted at."},
+			{Name: "response_code", Type: proto.ColumnType_INT, Transform: transform.FromField("AuditEvent.ResponseStatus.Code"), Description: "The HTTP response code returned for the request."},
+			{Name: "response_status", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.ResponseStatus"), Description: "The response status, populated even when the response object is not a Status type."},
+			{Name: "request_object", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.RequestObject"), Description: "The API object from the request, recorded at the Request and RequestResponse levels."},
+			{Name: "response_object", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.ResponseObject"), Description: "The API object returned in the response, recorded at the RequestResponse level."},
+			{Name: "request_received_timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AuditEvent.RequestReceivedTimestamp"), Description: "The time the request reached the API server."},
+			{Name: "stage_timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AuditEvent.StageTimestamp"), Description: "The time the request reached the current audit stage."},
+			{Name: "authorization_decision", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.Annotations").TransformP(eksAuditAnnotation, "authorization.k8s.io/decision"), Description: "The authorization decision for the request, allow or forbid."},
+			{Name: "authorization_reason", Type: proto.ColumnType_STRING, Transform: transform.FromField("AuditEvent.Annotations").TransformP(eksAuditAnnotation, "authorization.k8s.io/reason"), Description: "The reason given by the authorizer for the decision."},
+			{Name: "annotations", Type: proto.ColumnType_JSON, Transform: transform.FromField("AuditEvent.Annotations"), Description: "Unstructured key value map stored with the audit event, including the authorization decision and reason."},
+
+			// Other columns
+			{Name: "event_id", Type: proto.ColumnType_STRING, Description: "The ID of the event."},
+			{Name: "filter", Type: proto.ColumnType_STRING, Transform: transform.FromQual("filter"), Description: "The CloudWatch Logs filter pattern for the search."},
+			{Name: "ingestion_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("IngestionTime").Transform(transform.UnixMsToTimestamp), Description: "The time when the event was ingested."},
+			{Name: "message", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message").Transform(trim).Transform(transform.UnmarshalYAML), Description: "The audit event in json format."},
+		}),
+	}
+}
+
+// https://kubernetes.io/docs/reference/config-api/apiserver-audit.v1/#audit-k8s-io-v1-Event
+type eksAuditEvent struct {
+	Level                    *string            `json:"level"`
+	AuditID                  *string            `json:"auditID"`
+	Stage                    *string            `json:"stage"`
+	RequestURI               *string            `json:"requestURI"`
+	Verb                     *string            `json:"verb"`
+	User                     *eksAuditUserInfo  `json:"user"`
+	ImpersonatedUser         *eksAuditUserInfo  `json:"impersonatedUser"`
+	SourceIPs                []string           `json:"sourceIPs"`
+	UserAgent                *string            `json:"userAgent"`
+	ObjectRef                *eksAuditObjectRef `json:"objectRef"`
+	ResponseStatus           *eksAuditStatus    `json:"responseStatus"`
+	RequestObject            interface{}        `json:"requestObject"`
+	ResponseObject           interface{}        `json:"responseObject"`
+	RequestReceivedTimestamp *time.Time         `json:"requestReceivedTimestamp"`
+	StageTimestamp           *time.Time         `json:"stageTimestamp"`
+	Annotations              map[string]string  `json:"annotations"`
+}
+
+type eksAuditUserInfo struct {
+	Username *string             `json:"username,omitempty"`
+	UID      *string             `json:"uid,omitempty"`
+	Groups   []string            `json:"groups,omitempty"`
+	Extra    map[string][]string `json:"extra,omitempty"`
+}
+
+type eksAuditObjectRef struct {
+	Resource        *string `json:"resource,omitempty"`
+	Namespace       *string `json:"namespace,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	UID             *string `json:"uid,omitempty"`
+	APIGroup        *string `json:"apiGroup,omitempty"`
+	APIVersion      *string `json:"apiVersion,omitempty"`
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+	Subresource     *string `json:"subresource,omitempty"`
+}
+
+type eksAuditStatus struct {
+	Status  *string     `json:"status,omitempty"`
+	Message *string     `json:"message,omitempty"`
+	Reason  *string     `json:"reason,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+	Code    *int64      `json:"code,omitempty"`
+}
+
+type eksClusterAuditEvent struct {
+	ClusterName   *string
+	LogGroupName  *string
+	LogStreamName *string
+	EventId       *string
+	Timestamp     *int64
+	IngestionTime *int64
+	Message       *string
+	AuditEvent    eksAuditEvent
+}
+
+//// LIST FUNCTION
+
+func listEksClusterAuditEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	clusterNames := []string{}
+	if d.EqualsQuals["cluster_name"] != nil {
+		if listValue := d.EqualsQuals["cluster_name"].GetListValue(); listValue != nil {
+			for _, v := range listValue.Values {
+				clusterNames = append(clusterNames, v.GetStringValue())
+			}
+		} else {
+			clusterNames = append(clusterNames, d.EqualsQualString("cluster_name"))
+		}
+	} else {
+		names, err := listEksClustersWithAuditLogging(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		clusterNames = names
+	}
+
+	if len(clusterNames) == 0 {
+		return nil, nil
+	}
+
+	svc, err := CloudWatchLogsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_cluster_audit_event.listEksClusterAuditEvents", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(10000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	equalQuals := d.EqualsQuals
+
+	filterPattern := ""
+	if equalQuals["filter"] != nil {
+		filterPattern = equalQuals["filter"].GetStringValue()
+	} else if filter := buildEksClusterAuditEventFilter(equalQuals); len(filter) > 0 {
+		filterPattern = fmt.Sprintf("{ %s }", strings.Join(filter, " && "))
+	}
+
+	for _, clusterName := range clusterNames {
+		logGroupName := fmt.Sprintf("/aws/eks/%s/cluster", clusterName)
+
+		input := &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName: aws.String(logGroupName),
+			Limit:        aws.Int32(maxLimit),
+		}
+
+		// The log group also holds the api, authenticator, controllerManager
+		// and scheduler streams
+		if equalQuals["log_stream_name"] != nil {
+			input.LogStreamNames = []string{equalQuals["log_stream_name"].GetStringValue()}
+		} else {
+			input.LogStreamNamePrefix = aws.String("kube-apiserver-audit")
+		}
+
+		if filterPattern != "" {
+			input.FilterPattern = aws.String(filterPattern)
+		}
+
+		if d.Quals["timestamp"] != nil {
+			for _, q := range d.Quals["timestamp"].Quals {
+				tsMs := q.Value.GetTimestampValue().AsTime().UnixMilli()
+				switch q.Operator {
+				case "=":
+					input.StartTime = aws.Int64(tsMs)
+					input.EndTime = aws.Int64(tsMs)
+				case ">=", ">":
+					input.StartTime = aws.Int64(tsMs)
+				case "<", "<=":
+					input.EndTime = aws.Int64(tsMs)
+				}
+			}
+		}
+
+		paginator := cloudwatchlogs.NewFilterLogEventsPaginator(svc, input, func(o *cloudwatchlogs.FilterLogEventsPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				// Clusters listed explicitly by name may not have audit logging enabled
+				var ae smithy.APIError
+				if errors.As(err, &ae) && ae.ErrorCode() == "ResourceNotFoundException" {
+					break
+				}
+				plugin.Logger(ctx).Error("aws_eks_cluster_audit_event.listEksClusterAuditEvents", "api_error", err)
+				return nil, err
+			}
+
+			for _, e := range output.Events {
+				event := eksClusterAuditEvent{
+					ClusterName:   aws.String(clusterName),
+					LogGroupName:  aws.String(logGroupName),
+					LogStreamName: e.LogStreamName,
+					EventId:       e.EventId,
+					Timestamp:     e.Timestamp,
+					IngestionTime: e.IngestionTime,
+					Message:       e.Message,
+				}
+				if e.Message != nil {
+					if err := json.Unmarshal([]byte(*e.Message), &event.AuditEvent); err != nil {
+						plugin.Logger(ctx).Debug("aws_eks_cluster_audit_event.listEksClusterAuditEvents", "event_id", e.EventId, "unmarshal_error", err)
+					}
+				}
+				d.StreamListItem(ctx, event)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listEksClustersWithAuditLogging returns the clusters in the region that
+// send API server audit logs to CloudWatch Logs.
+func listEksClustersWithAuditLogging(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	svc, err := EKSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_eks_cluster_audit_event.listEksClustersWithAuditLogging", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	clusterNames := []string{}
+	paginator := eks.NewListClustersPaginator(svc, &eks.ListClustersInput{MaxResults: aws.Int32(100)}, func(o *eks.ListClustersPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_eks_cluster_audit_event.listEksClustersWithAuditLogging", "api_error", err)
+			return nil, err
+		}
+
+		for _, name := range output.Clusters {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			cluster, err := svc.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_eks_cluster_audit_event.listEksClustersWithAuditLogging", "cluster_name", name, "api_error", err)
+				return nil, err
+			}
+			if isEksClusterAuditLoggingEnabled(cluster.Cluster) {
+				clusterNames = append(clusterNames, name)
+			}
+		}
+	}
+
+	return clusterNames, nil
+}
+
+func isEksClusterAuditLoggingEnabled(cluster *eksTypes.Cluster) bool {
+	if cluster == nil || cluster.Logging == nil {
+		return false
+	}
+	for _, setup := range cluster.Logging.ClusterLogging {
+		if setup.Enabled == nil || !*setup.Enabled {
+			continue
+		}
+		for _, logType := range setup.Types {
+			if logType == eksTypes.LogTypeAudit {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func buildEksClusterAuditEventFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
+	filters := []string{}
+
+	filterQuals := map[string]string{
+		"impersonated_username": "impersonatedUser.username",
+		"namespace":             "objectRef.namespace",
+		"object_name":           "objectRef.name",
+		"resource":              "objectRef.resource",
+		"response_code":         "responseStatus.code",
+		"stage":                 "stage",
+		"subresource":           "objectRef.subresource",
+		"username":              "user.username",
+		"verb":                  "verb",
+	}
+
+	for qual, selector := range filterQuals {
+		if equalQuals[qual] != nil {
+			filters = append(filters, cloudwatchLogsJsonFilterTerm(selector, equalQuals[qual]))
+		}
+	}
+
+	return filters
+}
+
+//// TRANSFORM FUNCTIONS
+
+func eksAuditAnnotation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	annotations, ok := d.Value.(map[string]string)
+	if !ok {
+		return nil, nil
+	}
+	if value, ok := annotations[d.Param.(string)]; ok {
+		return value, nil
+	}
+	return nil, nil
+}
@@ -40,6 +40,11 @@ func tableAwsElastiCacheCluster(_ context.Context) *plugin.Table {
 				Func: listTagsForElastiCacheCluster,
 				Tags: map[string]string{"service": "elasticache", "action": "ListTagsForResource"},
 			},
+			{
+				Func:         getElastiCacheClusterEstimatedCost,
+				Tags:         map[string]string{"service": "pricing", "action": "GetProducts"},
+				IgnoreConfig: pricingIndexIgnoreConfig,
+			},
 		},
 
 		GetMatrixItemFunc: SupportedRegionMatrix(elasticachev1.EndpointsID),
@@ -165,6 +170,18 @@ func tableAwsElastiCacheCluster(_ context.Context) *plugin.Table {
 				Description: "A list of VPC Security Groups associated with the cluster.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "estimated_hourly_cost",
+				Description: "The estimated on-demand hourly cost of the nodes of the cluster, by node type, engine and region, from the public price list. Null if the price is unknown. Reserved nodes are not taken into account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getElastiCacheClusterEstimatedCost,
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The estimated on-demand monthly cost of the nodes of the cluster, i.e. 730 times the estimated hourly cost.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getElastiCacheClusterEstimatedCost,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags associated with the cluster.",
@@ -316,6 +333,19 @@ func listTagsForElastiCacheCluster(ctx context.Context, d *plugin.QueryData, h *
 	return clusterTags, nil
 }
 
+func getElastiCacheClusterEstimatedCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(types.CacheCluster)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	index, err := getPricingIndex(ctx, d, "AmazonElastiCache", region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_cluster.getElastiCacheClusterEstimatedCost", "pricing_index_error", err)
+		return nil, err
+	}
+
+	return estimateElastiCacheClusterCost(index, aws.ToString(cluster.CacheNodeType), aws.ToString(cluster.Engine), aws.ToInt32(cluster.NumCacheNodes)), nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func clusterTagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
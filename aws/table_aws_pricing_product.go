@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/pricing"
@@ -25,6 +26,7 @@ func tableAwsPricingProduct(_ context.Context) *plugin.Table {
 			Tags:    map[string]string{"service": "pricing", "action": "GetProducts"},
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "service_code", Require: plugin.Required},
+				{Name: "region_code", Require: plugin.Optional},
 				{Name: "filters", Require: plugin.Optional, CacheMatch: "exact"},
 			},
 		},
@@ -44,6 +46,7 @@ func tableAwsPricingProduct(_ context.Context) *plugin.Table {
 			{Name: "publication_date", Description: "The publication date of the offer.", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("PublicationDate")},
 			{Name: "effective_date", Description: "The effective date of the pricing details.", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Offer.EffectiveDate")},
 			{Name: "version", Description: "The publication version of the offer.", Type: proto.ColumnType_STRING, Transform: transform.FromField("Version")},
+			{Name: "region_code", Description: "The code of the region of the product, e.g. us-east-1.", Type: proto.ColumnType_STRING, Transform: transform.From(getPricingProductRegionCode)},
 			// product attributes
 			{Name: "attributes", Description: "Product attributes.", Type: proto.ColumnType_JSON, Transform: transform.FromField("Product.Attributes")},
 			// product attributes filters
@@ -56,6 +59,7 @@ func tableAwsPricingProduct(_ context.Context) *plugin.Table {
 
 type Product struct {
 	_             struct{} `type:"structure"`
+	Sku           *string
 	ProductFamily *string
 	Attributes    map[string]*string
 }
@@ -95,7 +99,32 @@ type PriceList struct {
 	PublicationDate *time.Time
 }
 
+type OfferOutput struct {
+	_              struct{} `type:"structure"`
+	PriceDimension *PriceDimension
+	Term           string
+	EffectiveDate  *time.Time
+	OfferTermCode  *string
+	TermAttributes *TermAttributes
+}
+
+// PriceOutput is a row of the table, i.e. a price dimension of an offer term
+// of a product.
+type PriceOutput struct {
+	_               struct{} `type:"structure"`
+	Product         *Product
+	ServiceCode     *string
+	Offer           *OfferOutput
+	Version         *string
+	PublicationDate *time.Time
+}
+
 func listPricingProduct(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Read the bulk offer files instead of calling GetProducts if configured
+	if GetConfig(d.Connection).PricingOfferFilePath != nil {
+		return listPricingProductFromOfferFiles(ctx, d)
+	}
+
 	// Create Session
 	svc, err := PricingClient(ctx, d)
 	if err != nil {
@@ -127,30 +156,20 @@ func listPricingProduct(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		MaxResults:    aws.Int32(maxItems),
 	}
 
-	type OfferOutput struct {
-		_              struct{} `type:"structure"`
-		PriceDimension *PriceDimension
-		Term           string
-		EffectiveDate  *time.Time
-		OfferTermCode  *string
-		TermAttributes *TermAttributes
-	}
-
-	type PriceOutput struct {
-		_               struct{} `type:"structure"`
-		Product         *Product
-		ServiceCode     *string
-		Offer           *OfferOutput
-		Version         *string
-		PublicationDate *time.Time
-	}
-
 	filters, err := buildPricingFilter(d.Quals["filters"])
 	if err != nil {
 		plugin.Logger(ctx).Error("aws_pricing_product.listPricingProduct", "filters_building_error", err)
 		return nil, err
 	}
 
+	if regionCode := d.EqualsQualString("region_code"); regionCode != "" {
+		filters = append(filters, types.Filter{
+			Field: aws.String("regionCode"),
+			Type:  types.FilterTypeTermMatch,
+			Value: aws.String(regionCode),
+		})
+	}
+
 	if len(filters) > 0 {
 		input.Filters = filters
 	}
@@ -203,6 +222,55 @@ func listPricingProduct(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, nil
 }
 
+// listPricingProductFromOfferFiles streams the prices of the offer file of the
+// service, or of the region if the region_code qual is set, with the filters
+// evaluated against the product attributes.
+func listPricingProductFromOfferFiles(ctx context.Context, d *plugin.QueryData) (interface{}, error) {
+	filters, err := buildPricingFilter(d.Quals["filters"])
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_pricing_product.listPricingProductFromOfferFiles", "filters_building_error", err)
+		return nil, err
+	}
+
+	query := pricingOfferFileQuery{
+		Product: func(product *Product) bool {
+			return pricingProductMatchesFilters(product, filters)
+		},
+	}
+	serviceCode := d.EqualsQualString("service_code")
+	regionCode := d.EqualsQualString("region_code")
+
+	_, err = readPricingOfferFiles(ctx, d, serviceCode, regionCode, query, func(row *PriceOutput) bool {
+		d.StreamListItem(ctx, *row)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_pricing_product.listPricingProductFromOfferFiles", "offer_file_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// pricingProductMatchesFilters evaluates the GetProducts filters against the
+// product. As for GetProducts, the attribute names are matched ignoring case.
+func pricingProductMatchesFilters(product *Product, filters []types.Filter) bool {
+	for _, filter := range filters {
+		var value *string
+		if strings.EqualFold(aws.StringValue(filter.Field), "productFamily") {
+			value = product.ProductFamily
+		} else {
+			value = pricingProductAttribute(product, aws.StringValue(filter.Field))
+		}
+		if value == nil || *value != aws.StringValue(filter.Value) {
+			return false
+		}
+	}
+	return true
+}
+
 func extractPricePerUnit(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	priceMap := d.Value.(map[string]*string)
 
@@ -221,6 +289,15 @@ func extractCurrency(_ context.Context, d *transform.TransformData) (interface{}
 	return nil, nil
 }
 
+// getPricingProductRegionCode returns the regionCode attribute of the product.
+func getPricingProductRegionCode(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	item := d.HydrateItem.(PriceOutput)
+	if item.Product == nil {
+		return nil, nil
+	}
+	return pricingProductAttribute(item.Product, "regionCode"), nil
+}
+
 // build pricing list call input filter
 func buildPricingFilter(qual *plugin.KeyColumnQuals) ([]types.Filter, error) {
 	if qual == nil {
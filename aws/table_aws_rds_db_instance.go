@@ -51,6 +51,11 @@ func tableAwsRDSDBInstance(_ context.Context) *plugin.Table {
 				Func: getRDSDBInstanceProcessorFeatures,
 				Tags: map[string]string{"service": "rds", "action": "DescribeOrderableDBInstanceOptions"},
 			},
+			{
+				Func:         getRDSDBInstanceEstimatedCost,
+				Tags:         map[string]string{"service": "pricing", "action": "GetProducts"},
+				IgnoreConfig: pricingIndexIgnoreConfig,
+			},
 		},
 		GetMatrixItemFunc: SupportedRegionMatrix(rdsv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -434,6 +439,18 @@ func tableAwsRDSDBInstance(_ context.Context) *plugin.Table {
 				Description: "A list of VPC security group elements that the DB instance belongs to.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "estimated_hourly_cost",
+				Description: "The estimated hourly cost of the DB instance, i.e. the estimated monthly cost divided by 730.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getRDSDBInstanceEstimatedCost,
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The estimated on-demand monthly cost of the DB instance hours and allocated storage, by instance class, engine, license model, deployment option, storage type and region, from the public price list. Instance hours are not included while the DB instance is stopped. Null if the price is unknown. Aurora storage, provisioned IOPS, backups and Reserved Instances are not taken into account.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getRDSDBInstanceEstimatedCost,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags attached to the DB Instance.",
@@ -615,6 +632,19 @@ func getRDSDBInstanceCertificate(ctx context.Context, d *plugin.QueryData, h *pl
 	return nil, nil
 }
 
+func getRDSDBInstanceEstimatedCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dbInstance := h.Item.(types.DBInstance)
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	index, err := getPricingIndex(ctx, d, "AmazonRDS", region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_instance.getRDSDBInstanceEstimatedCost", "pricing_index_error", err)
+		return nil, err
+	}
+
+	return estimateRDSDBInstanceCost(index, aws.ToString(dbInstance.DBInstanceClass), aws.ToString(dbInstance.Engine), aws.ToString(dbInstance.LicenseModel), dbInstance.MultiAZ, aws.ToString(dbInstance.StorageType), dbInstance.AllocatedStorage, aws.ToString(dbInstance.DBInstanceStatus)), nil
+}
+
 // DescribeDBInstances API returns the non-default ProcessorFeature value.
 // For populating the default ProcessorFeature value we need to make DescribeOrderableDBInstanceOptions API call.
 func getRDSDBInstanceProcessorFeatures(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
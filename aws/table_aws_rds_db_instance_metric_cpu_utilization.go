@@ -1,39 +0,0 @@
-package aws
-
-import (
-	"context"
-
-	"github.com/aws/aws-sdk-go-v2/service/rds/types"
-	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
-	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
-)
-
-//// TABLE DEFINITION
-
-func tableAwsRdsInstanceMetricCpuUtilization(_ context.Context) *plugin.Table {
-	return &plugin.Table{
-		Name:        "aws_rds_db_instance_metric_cpu_utilization",
-		Description: "AWS RDS DB Instance Cloudwatch Metrics - CPU Utilization",
-		List: &plugin.ListConfig{
-			ParentHydrate: listRDSDBInstances,
-			Hydrate:       listRdsInstanceMetricCpuUtilization,
-			Tags:          map[string]string{"service": "cloudwatch", "action": "GetMetricStatistics"},
-		},
-		GetMatrixItemFunc: CloudWatchRegionsMatrix,
-		Columns: awsRegionalColumns(cwMetricColumns(
-			[]*plugin.Column{
-				{
-					Name:        "db_instance_identifier",
-					Description: "The friendly name to identify the DB Instance.",
-					Type:        proto.ColumnType_STRING,
-					Transform:   transform.FromField("DimensionValue"),
-				},
-			})),
-	}
-}
-
-func listRdsInstanceMetricCpuUtilization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	instance := h.Item.(types.DBInstance)
-	return listCWMetricStatistics(ctx, d, "5_MIN", "AWS/RDS", "CPUUtilization", "DBInstanceIdentifier", *instance.DBInstanceIdentifier)
-}
@@ -0,0 +1,437 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	route53resolverTypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	route53resolverv1 "github.com/aws/aws-sdk-go/service/route53resolver"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAwsRoute53ResolverQueryLogEventListKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{Name: "query_log_config_id", Require: plugin.Optional},
+		{Name: "log_group_name", Require: plugin.Optional},
+		{Name: "log_stream_name", Require: plugin.Optional},
+		{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+		{Name: "region", Require: plugin.Optional},
+		{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+
+		// event fields
+		{Name: "query_name", Require: plugin.Optional},
+		{Name: "query_type", Require: plugin.Optional},
+		{Name: "query_class", Require: plugin.Optional},
+		{Name: "rcode", Require: plugin.Optional},
+		{Name: "src_addr", Require: plugin.Optional},
+		{Name: "vpc_id", Require: plugin.Optional},
+		{Name: "source_instance_id", Require: plugin.Optional},
+		{Name: "transport", Require: plugin.Optional},
+		{Name: "firewall_rule_action", Require: plugin.Optional},
+	}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverQueryLogEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_query_log_event",
+		Description: "AWS Route 53 Resolver query log events from CloudWatch Logs and S3",
+		List: &plugin.ListConfig{
+			Hydrate:    listRoute53ResolverQueryLogEvents,
+			Tags:       map[string]string{"service": "route53resolver", "action": "ListResolverQueryLogConfigs"},
+			KeyColumns: tableAwsRoute53ResolverQueryLogEventListKeyColumns(),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "NoSuchBucket"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(route53resolverv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			// Top columns
+			{Name: "query_log_config_id", Type: proto.ColumnType_STRING, Description: "The ID of the query logging configuration that delivered the event."},
+			{Name: "destination_arn", Type: proto.ColumnType_STRING, Description: "The ARN of the CloudWatch Logs log group or S3 bucket the event was read from."},
+			{Name: "log_group_name", Type: proto.ColumnType_STRING, Description: "The name of the log group to which this event belongs. Null for events read from S3."},
+			{Name: "log_stream_name", Type: proto.ColumnType_STRING, Description: "The name of the log stream to which this event belongs. Null for events read from S3."},
+			{Name: "s3_object_key", Type: proto.ColumnType_STRING, Transform: transform.FromField("S3ObjectKey"), Description: "The key of the S3 object the event was read from. Null for events read from CloudWatch Logs."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp), Description: "The time when the event occurred."},
+
+			// Query log record fields
+			{Name: "version", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.Version"), Description: "The version number of the query log format."},
+			{Name: "query_timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Record.QueryTimestamp"), Description: "The date and time that the query was submitted, in ISO 8601 format and Coordinated Universal Time (UTC)."},
+			{Name: "query_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.QueryName"), Description: "The domain name (example.com) or subdomain name (www.example.com) that was specified in the query."},
+			{Name: "query_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.QueryType"), Description: "The DNS record type that was specified in the request, or ANY."},
+			{Name: "query_class", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.QueryClass"), Description: "The class of the query."},
+			{Name: "rcode", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.Rcode"), Description: "The DNS response code that Resolver returned in response to the DNS query, such as NOERROR or NXDOMAIN."},
+			{Name: "answers", Type: proto.ColumnType_JSON, Transform: transform.FromField("Record.Answers"), Description: "The answers that Resolver returned in response to the DNS query, including the record data, type and class."},
+			{Name: "src_addr", Type: proto.ColumnType_IPADDR, Transform: transform.FromField("Record.SrcAddr"), Description: "The IP address of the instance that originated the query."},
+			{Name: "src_port", Type: proto.ColumnType_INT, Transform: transform.FromField("Record.SrcPort"), Description: "The port on the instance that originated the query."},
+			{Name: "transport", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.Transport"), Description: "The protocol used to submit the DNS query."},
+			{Name: "vpc_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.VpcId"), Description: "The ID of the VPC that the query originated in."},
+			{Name: "source_instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.SrcIds.Instance"), Description: "The ID of the instance that originated the query."},
+			{Name: "source_resolver_endpoint_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.SrcIds.ResolverEndpoint"), Description: "The ID of the inbound Resolver endpoint that passed the query to Route 53 Resolver."},
+			{Name: "src_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("Record.SrcIds"), Description: "The IDs of the resources the query originated from."},
+			{Name: "firewall_rule_action", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.FirewallRuleAction"), Description: "The action specified by the DNS Firewall rule that matched the domain name in the query, such as ALERT or BLOCK."},
+			{Name: "firewall_rule_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.FirewallRuleGroupId"), Description: "The ID of the DNS Firewall rule group that matched the domain name in the query."},
+			{Name: "firewall_domain_list_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Record.FirewallDomainListId"), Description: "The ID of the domain list used by the DNS Firewall rule that matched the domain name in the query."},
+
+			// Other columns
+			{Name: "event_id", Type: proto.ColumnType_STRING, Description: "The ID of the event. Null for events read from S3."},
+			{Name: "filter", Type: proto.ColumnType_STRING, Transform: transform.FromQual("filter"), Description: "The CloudWatch Logs filter pattern for the search."},
+			{Name: "ingestion_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("IngestionTime").Transform(transform.UnixMsToTimestamp), Description: "The time when the event was ingested. Null for events read from S3."},
+			{Name: "message", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message").Transform(trim).Transform(transform.UnmarshalYAML), Description: "The query log record in json format."},
+		}),
+	}
+}
+
+// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/resolver-query-logs-format.html
+type route53ResolverQueryLogRecord struct {
+	Version              *string                          `json:"version"`
+	AccountId            *string                          `json:"account_id"`
+	Region               *string                          `json:"region"`
+	VpcId                *string                          `json:"vpc_id"`
+	QueryTimestamp       *time.Time                       `json:"query_timestamp"`
+	QueryName            *string                          `json:"query_name"`
+	QueryType            *string                          `json:"query_type"`
+	QueryClass           *string                          `json:"query_class"`
+	Rcode                *string                          `json:"rcode"`
+	Answers              []route53ResolverQueryLogAnswer  `json:"answers"`
+	SrcAddr              *string                          `json:"srcaddr"`
+	SrcPort              *string                          `json:"srcport"`
+	Transport            *string                          `json:"transport"`
+	SrcIds               *route53ResolverQueryLogSourceId `json:"srcids"`
+	FirewallRuleAction   *string                          `json:"firewall_rule_action"`
+	FirewallRuleGroupId  *string                          `json:"firewall_rule_group_id"`
+	FirewallDomainListId *string                          `json:"firewall_domain_list_id"`
+}
+
+type route53ResolverQueryLogAnswer struct {
+	Rdata string `json:"Rdata"`
+	Type  string `json:"Type"`
+	Class string `json:"Class"`
+}
+
+type route53ResolverQueryLogSourceId struct {
+	Instance         *string `json:"instance,omitempty"`
+	ResolverEndpoint *string `json:"resolver_endpoint,omitempty"`
+}
+
+type route53ResolverQueryLogEvent struct {
+	QueryLogConfigId *string
+	DestinationArn   *string
+	LogGroupName     *string
+	LogStreamName    *string
+	S3ObjectKey      *string
+	EventId          *string
+	Timestamp        *int64
+	IngestionTime    *int64
+	Message          string
+	Record           route53ResolverQueryLogRecord
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverQueryLogEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEvents", "client_error", err)
+		return nil, err
+	}
+
+	// Unsupported region check
+	if svc == nil {
+		return nil, nil
+	}
+
+	input := &route53resolver.ListResolverQueryLogConfigsInput{
+		MaxResults: aws.Int32(100),
+	}
+	if id := d.EqualsQualString("query_log_config_id"); id != "" {
+		input.Filters = []route53resolverTypes.Filter{{Name: aws.String("Id"), Values: []string{id}}}
+	}
+
+	configs := []route53resolverTypes.ResolverQueryLogConfig{}
+	paginator := route53resolver.NewListResolverQueryLogConfigsPaginator(svc, input, func(o *route53resolver.ListResolverQueryLogConfigsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEvents", "api_error", err)
+			return nil, err
+		}
+		configs = append(configs, output.ResolverQueryLogConfigs...)
+	}
+
+	logGroupName := d.EqualsQualString("log_group_name")
+	startTime, endTime := timestampQualRange(d.Quals)
+
+	for _, config := range configs {
+		if config.DestinationArn == nil {
+			continue
+		}
+		destination, err := arn.Parse(*config.DestinationArn)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEvents", "destination_arn", *config.DestinationArn, "parse_error", err)
+			continue
+		}
+
+		switch destination.Service {
+		case "logs":
+			// arn:aws:logs:us-east-1:123456789012:log-group:my-log-group
+			groupName := strings.TrimSuffix(strings.TrimPrefix(destination.Resource, "log-group:"), ":*")
+			if logGroupName != "" && logGroupName != groupName {
+				continue
+			}
+			if err := listRoute53ResolverQueryLogEventsFromLogGroup(ctx, d, config, groupName, startTime, endTime); err != nil {
+				return nil, err
+			}
+		case "s3":
+			// Events delivered to S3 have no log group
+			if logGroupName != "" || d.EqualsQualString("log_stream_name") != "" {
+				continue
+			}
+			if err := listRoute53ResolverQueryLogEventsFromS3(ctx, d, h, config, destination.Resource, region, startTime, endTime); err != nil {
+				return nil, err
+			}
+		}
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listRoute53ResolverQueryLogEventsFromLogGroup(ctx context.Context, d *plugin.QueryData, config route53resolverTypes.ResolverQueryLogConfig, logGroupName string, startTime, endTime *time.Time) error {
+	svc, err := CloudWatchLogsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromLogGroup", "get_client_error", err)
+		return err
+	}
+
+	// Limiting the results
+	maxLimit := int32(10000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(logGroupName),
+		Limit:        aws.Int32(maxLimit),
+	}
+
+	if d.EqualsQuals["log_stream_name"] != nil {
+		input.LogStreamNames = []string{d.EqualsQualString("log_stream_name")}
+	}
+
+	if d.EqualsQuals["filter"] != nil {
+		input.FilterPattern = aws.String(d.EqualsQualString("filter"))
+	} else if filter := buildRoute53ResolverQueryLogFilter(d.EqualsQuals); len(filter) > 0 {
+		input.FilterPattern = aws.String(fmt.Sprintf("{ %s }", strings.Join(filter, " && ")))
+	}
+
+	if startTime != nil {
+		input.StartTime = aws.Int64(startTime.UnixMilli())
+	}
+	if endTime != nil {
+		input.EndTime = aws.Int64(endTime.UnixMilli())
+	}
+
+	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(svc, input, func(o *cloudwatchlogs.FilterLogEventsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromLogGroup", "api_error", err)
+			return err
+		}
+
+		for _, e := range output.Events {
+			if e.Message == nil {
+				continue
+			}
+			event := route53ResolverQueryLogEvent{
+				QueryLogConfigId: config.Id,
+				DestinationArn:   config.DestinationArn,
+				LogGroupName:     aws.String(logGroupName),
+				LogStreamName:    e.LogStreamName,
+				EventId:          e.EventId,
+				Timestamp:        e.Timestamp,
+				IngestionTime:    e.IngestionTime,
+				Message:          *e.Message,
+			}
+			if err := json.Unmarshal([]byte(*e.Message), &event.Record); err != nil {
+				plugin.Logger(ctx).Debug("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromLogGroup", "event_id", e.EventId, "unmarshal_error", err)
+			}
+			d.StreamListItem(ctx, event)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// Resolver delivers query logs to S3 as gzipped JSON lines under
+// <prefix>/AWSLogs/<account-id>/vpcdnsquerylogs/<vpc-id>/<yyyy>/<mm>/<dd>/
+// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/resolver-query-logs-choosing-target-resource.html
+func listRoute53ResolverQueryLogEventsFromS3(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, config route53resolverTypes.ResolverQueryLogConfig, resource string, region string, startTime, endTime *time.Time) error {
+	bucketName, prefix, _ := strings.Cut(resource, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+
+	if config.OwnerId == nil {
+		return nil
+	}
+	basePrefix := fmt.Sprintf("%sAWSLogs/%s/vpcdnsquerylogs/", prefix, *config.OwnerId)
+
+	svc, err := s3ClientForBucket(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromS3", "bucket_name", bucketName, "client_error", err)
+		return err
+	}
+
+	vpcPrefixes := []string{}
+	if vpcId := d.EqualsQualString("vpc_id"); vpcId != "" {
+		vpcPrefixes = append(vpcPrefixes, basePrefix+vpcId+"/")
+	} else {
+		vpcPrefixes, err = listS3CommonPrefixes(ctx, d, svc, bucketName, basePrefix)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromS3", "bucket_name", bucketName, "api_error", err)
+			return err
+		}
+	}
+
+	for _, vpcPrefix := range vpcPrefixes {
+		for _, objectPrefix := range s3DailyLogPrefixes(vpcPrefix, "2006/01/02/", startTime, endTime) {
+			err := streamS3LogObjectLines(ctx, d, svc, bucketName, objectPrefix, func(key string, line string) bool {
+				event := route53ResolverQueryLogEvent{
+					QueryLogConfigId: config.Id,
+					DestinationArn:   config.DestinationArn,
+					S3ObjectKey:      aws.String(key),
+					Message:          line,
+				}
+				if err := json.Unmarshal([]byte(line), &event.Record); err != nil {
+					plugin.Logger(ctx).Debug("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromS3", "key", key, "unmarshal_error", err)
+					return true
+				}
+				// A bucket may receive query logs from several regions
+				if event.Record.Region != nil && *event.Record.Region != region {
+					return true
+				}
+				if event.Record.QueryTimestamp != nil {
+					event.Timestamp = aws.Int64(event.Record.QueryTimestamp.UnixMilli())
+				}
+				d.StreamListItem(ctx, event)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				return d.RowsRemaining(ctx) != 0
+			})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_route53_resolver_query_log_event.listRoute53ResolverQueryLogEventsFromS3", "bucket_name", bucketName, "prefix", objectPrefix, "api_error", err)
+				return err
+			}
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+//// UTILITY FUNCTIONS
+
+// route53ResolverQueryLogFilterQuals maps the qual columns to the selectors of
+// the log event fields they filter on, sorted by column so that the same quals
+// always build the same filter pattern.
+var route53ResolverQueryLogFilterQuals = []struct {
+	qual     string
+	selector string
+}{
+	{"firewall_rule_action", "firewall_rule_action"},
+	{"query_class", "query_class"},
+	{"query_name", "query_name"},
+	{"query_type", "query_type"},
+	{"rcode", "rcode"},
+	{"source_instance_id", "srcids.instance"},
+	{"src_addr", "srcaddr"},
+	{"transport", "transport"},
+	{"vpc_id", "vpc_id"},
+}
+
+func buildRoute53ResolverQueryLogFilter(equalQuals plugin.KeyColumnEqualsQualMap) []string {
+	filters := []string{}
+
+	for _, filterQual := range route53ResolverQueryLogFilterQuals {
+		if equalQuals[filterQual.qual] != nil {
+			filters = append(filters, cloudwatchLogsJsonFilterTerm(filterQual.selector, equalQuals[filterQual.qual]))
+		}
+	}
+
+	return filters
+}
+
+// cloudwatchLogsJsonFilterTerm builds a JSON filter pattern term matching the
+// selector against the qual value. A list of values, e.g. from an IN clause,
+// is matched with ||.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html#matching-terms-json-log-events
+func cloudwatchLogsJsonFilterTerm(selector string, value *proto.QualValue) string {
+	if listValue := value.GetListValue(); listValue != nil {
+		terms := []string{}
+		for _, v := range listValue.Values {
+			terms = append(terms, cloudwatchLogsJsonFilterTerm(selector, v))
+		}
+		return fmt.Sprintf("( %s )", strings.Join(terms, " || "))
+	}
+
+	switch v := value.GetValue().(type) {
+	case *proto.QualValue_Int64Value:
+		return fmt.Sprintf("( $.%s = %d )", selector, v.Int64Value)
+	case *proto.QualValue_BoolValue:
+		return fmt.Sprintf("( $.%s IS %t )", selector, v.BoolValue)
+	case *proto.QualValue_InetValue:
+		return fmt.Sprintf("( $.%s = \"%s\" )", selector, v.InetValue.GetAddr())
+	}
+	return fmt.Sprintf("( $.%s = \"%s\" )", selector, cloudwatchLogsFilterStringEscaper.Replace(value.GetStringValue()))
+}
+
+// cloudwatchLogsFilterStringEscaper escapes the quotes and backslashes of a
+// string matched in a filter pattern.
+var cloudwatchLogsFilterStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
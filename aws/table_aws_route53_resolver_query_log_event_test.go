@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestBuildRoute53ResolverQueryLogFilter(t *testing.T) {
+	stringValue := func(s string) *proto.QualValue {
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: s}}
+	}
+	quals := plugin.KeyColumnEqualsQualMap{
+		"vpc_id":     stringValue("vpc-1234"),
+		"query_name": stringValue(`example.com."\`),
+		"query_type": {Value: &proto.QualValue_ListValue{ListValue: &proto.QualValueList{Values: []*proto.QualValue{stringValue("A"), stringValue("AAAA")}}}},
+	}
+	expected := []string{
+		`( $.query_name = "example.com.\"\\" )`,
+		`( ( $.query_type = "A" ) || ( $.query_type = "AAAA" ) )`,
+		`( $.vpc_id = "vpc-1234" )`,
+	}
+
+	for i := 0; i < 10; i++ {
+		filter := buildRoute53ResolverQueryLogFilter(quals)
+		if len(filter) != len(expected) {
+			t.Fatalf("unexpected filter %v", filter)
+		}
+		for j := range expected {
+			if filter[j] != expected[j] {
+				t.Errorf("unexpected term %d: %s", j, filter[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3AccessGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_access_grant",
+		Description: "AWS S3 Access Grant",
+		List: &plugin.ListConfig{
+			Hydrate: listS3AccessGrants,
+			Tags:    map[string]string{"service": "s3", "action": "ListAccessGrants"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "grantee_type", Require: plugin.Optional},
+				{Name: "grantee_identifier", Require: plugin.Optional},
+				{Name: "permission", Require: plugin.Optional},
+				{Name: "grant_scope", Require: plugin.Optional},
+				{Name: "application_arn", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Regions without an S3 Access Grants instance have no grants
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessGrantsInstanceNotExistsError", "InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "access_grant_id",
+				Description: "The ID of the access grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the access grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantArn"),
+			},
+			{
+				Name:        "grant_scope",
+				Description: "The S3 path of the data the access grant gives access to, the location scope with the sub prefix appended.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission",
+				Description: "The type of access granted, READ, WRITE or READWRITE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grantee_type",
+				Description: "The type of the grantee, IAM, DIRECTORY_USER or DIRECTORY_GROUP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grantee.GranteeType"),
+			},
+			{
+				Name:        "grantee_identifier",
+				Description: "The identifier of the grantee, the ARN of an IAM user or role, or the UUID of a directory user or group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Grantee.GranteeIdentifier"),
+			},
+			{
+				Name:        "access_grants_location_id",
+				Description: "The ID of the registered location the access grant is for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_sub_prefix",
+				Description: "The sub prefix appended to the location scope to narrow the scope of the access grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantsLocationConfiguration.S3SubPrefix"),
+			},
+			{
+				Name:        "application_arn",
+				Description: "The ARN of the IAM Identity Center application the grantee must access the data through, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the access grant was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessGrantArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3AccessGrants(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(1000)
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &s3control.ListAccessGrantsInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: maxItems,
+	}
+	if granteeType := d.EqualsQualString("grantee_type"); granteeType != "" {
+		input.GranteeType = types.GranteeType(granteeType)
+	}
+	if granteeIdentifier := d.EqualsQualString("grantee_identifier"); granteeIdentifier != "" {
+		input.GranteeIdentifier = aws.String(granteeIdentifier)
+	}
+	if permission := d.EqualsQualString("permission"); permission != "" {
+		input.Permission = types.Permission(permission)
+	}
+	if grantScope := d.EqualsQualString("grant_scope"); grantScope != "" {
+		input.GrantScope = aws.String(grantScope)
+	}
+	if applicationArn := d.EqualsQualString("application_arn"); applicationArn != "" {
+		input.ApplicationArn = aws.String(applicationArn)
+	}
+
+	paginator := s3control.NewListAccessGrantsPaginator(svc, input, func(o *s3control.ListAccessGrantsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_access_grant.listS3AccessGrants", "api_error", err)
+			return nil, err
+		}
+
+		for _, grant := range output.AccessGrantsList {
+			d.StreamListItem(ctx, grant)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
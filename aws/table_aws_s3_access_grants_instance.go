@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3AccessGrantsInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_access_grants_instance",
+		Description: "AWS S3 Access Grants Instance",
+		List: &plugin.ListConfig{
+			Hydrate: listS3AccessGrantsInstances,
+			Tags:    map[string]string{"service": "s3", "action": "ListAccessGrantsInstances"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "access_grants_instance_id",
+				Description: "The ID of the S3 Access Grants instance. There is one instance per region per account, whose ID is default.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the S3 Access Grants instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantsInstanceArn"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the S3 Access Grants instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "identity_center_arn",
+				Description: "The ARN of the IAM Identity Center application associated with the S3 Access Grants instance, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantsInstanceId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessGrantsInstanceArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3AccessGrantsInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grants_instance.listS3AccessGrantsInstances", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grants_instance.listS3AccessGrantsInstances", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(1000)
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &s3control.ListAccessGrantsInstancesInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: maxItems,
+	}
+
+	paginator := s3control.NewListAccessGrantsInstancesPaginator(svc, input, func(o *s3control.ListAccessGrantsInstancesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_access_grants_instance.listS3AccessGrantsInstances", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range output.AccessGrantsInstancesList {
+			d.StreamListItem(ctx, instance)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
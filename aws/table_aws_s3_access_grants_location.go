@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3AccessGrantsLocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_access_grants_location",
+		Description: "AWS S3 Access Grants Location",
+		List: &plugin.ListConfig{
+			Hydrate: listS3AccessGrantsLocations,
+			Tags:    map[string]string{"service": "s3", "action": "ListAccessGrantsLocations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "location_scope", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Regions without an S3 Access Grants instance have no locations
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessGrantsInstanceNotExistsError", "InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "access_grants_location_id",
+				Description: "The ID of the registered location. The default location s3:// has the ID default.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the registered location.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessGrantsLocationArn"),
+			},
+			{
+				Name:        "location_scope",
+				Description: "The S3 path of the registered location, either the default location s3://, a bucket s3://<bucket> or a bucket and prefix s3://<bucket>/<prefix>.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_role_arn",
+				Description: "The ARN of the IAM role that S3 Access Grants assumes to manage access to the registered location.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IAMRoleArn"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the location was registered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationScope"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessGrantsLocationArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3AccessGrantsLocations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grants_location.listS3AccessGrantsLocations", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_access_grants_location.listS3AccessGrantsLocations", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(1000)
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &s3control.ListAccessGrantsLocationsInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: maxItems,
+	}
+	if locationScope := d.EqualsQualString("location_scope"); locationScope != "" {
+		input.LocationScope = aws.String(locationScope)
+	}
+
+	paginator := s3control.NewListAccessGrantsLocationsPaginator(svc, input, func(o *s3control.ListAccessGrantsLocationsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_access_grants_location.listS3AccessGrantsLocations", "api_error", err)
+			return nil, err
+		}
+
+		for _, location := range output.AccessGrantsLocationsList {
+			d.StreamListItem(ctx, location)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -99,6 +99,16 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Depends: []plugin.HydrateFunc{getBucketLocation},
 				Tags:    map[string]string{"service": "s3", "action": "GetBucketWebsite"},
 			},
+			{
+				Func:    getBucketEffectivePublicAccess,
+				Depends: []plugin.HydrateFunc{getBucketLocation, getBucketPublicAccessBlock, getBucketACL, getS3BucketObjectOwnershipControl, getBucketPolicy},
+				Tags:    map[string]string{"service": "s3", "action": "ListAccessPoints"},
+				// Without the s3:GetAccountPublicAccessBlock or access point
+				// permissions the evaluation is incomplete, so return null
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AccessDenied"}),
+				},
+			},
 		},
 		Columns: awsAccountColumns([]*plugin.Column{
 			{
@@ -126,6 +136,20 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketIsPublic,
 				Transform:   transform.FromField("PolicyStatus.IsPublic"),
 			},
+			{
+				Name:        "is_effectively_public",
+				Description: "True if the bucket can be accessed by anyone through its ACL, its policy or the policy of an attached access point or Multi-Region Access Point, after applying the account, bucket and access point Block Public Access settings and object ownership. Null if access to any of these settings is denied.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getBucketEffectivePublicAccess,
+				Transform:   transform.FromField("IsPublic"),
+			},
+			{
+				Name:        "effective_public_access",
+				Description: "The evaluation behind is_effectively_public, including the effective Block Public Access settings, public ACL grants, public policy statements, attached access points and the reasons the bucket is or isn't public.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketEffectivePublicAccess,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "versioning_enabled",
 				Description: "The versioning state of a bucket.",
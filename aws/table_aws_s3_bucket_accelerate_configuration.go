@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketAccelerateConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_accelerate_configuration",
+		Description: "AWS S3 Bucket Transfer Acceleration Configuration",
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketAccelerateConfigurations,
+			Tags:          map[string]string{"service": "s3", "action": "GetBucketAccelerateConfiguration"},
+			// Transfer acceleration isn't supported in every region
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"MethodNotAllowed", "UnsupportedArgument", "NotImplemented"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The transfer acceleration state of the bucket, Enabled or Suspended. Null if transfer acceleration has never been configured.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BucketName"),
+			},
+		}),
+	}
+}
+
+type AccelerateConfigurationInfo struct {
+	BucketName *string
+	Region     string
+	Status     types.BucketAccelerateStatus
+}
+
+//// LIST FUNCTION
+
+func listBucketAccelerateConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_accelerate_configuration.listBucketAccelerateConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketAccelerateConfigurationInput{
+		Bucket: bucket.Name,
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	op, err := svc.GetBucketAccelerateConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_accelerate_configuration.listBucketAccelerateConfigurations", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, &AccelerateConfigurationInfo{bucket.Name, region, op.Status})
+
+	return nil, nil
+}
@@ -0,0 +1,489 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketAccessLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_access_log",
+		Description: "AWS S3 Bucket Access Log",
+		List: &plugin.ListConfig{
+			Hydrate: listS3BucketAccessLogs,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional},
+				{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket that the request was processed against.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time at which the request was received.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "bucket_owner",
+				Description: "The canonical user ID of the owner of the source bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "remote_ip",
+				Description: "The apparent IP address of the requester.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "requester",
+				Description: "The canonical user ID or IAM ARN of the requester, or null for unauthenticated requests.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "request_id",
+				Description: "A string generated by Amazon S3 to uniquely identify each request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The operation, e.g. REST.GET.OBJECT or S3.TRANSITION.OBJECT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the object in the request, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "request_uri",
+				Description: "The Request-URI part of the HTTP request message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "http_status",
+				Description: "The numeric HTTP status code of the response.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "error_code",
+				Description: "The Amazon S3 error code, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bytes_sent",
+				Description: "The number of response bytes sent excluding HTTP protocol overhead.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "object_size",
+				Description: "The total size of the object in question.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "total_time",
+				Description: "The number of milliseconds that the request was in flight from the server's perspective.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "turn_around_time",
+				Description: "The number of milliseconds that Amazon S3 spent processing the request.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "referer",
+				Description: "The value of the HTTP Referer header, if present.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_agent",
+				Description: "The value of the HTTP User-Agent header.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_id",
+				Description: "The version ID in the request, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_id",
+				Description: "The x-amz-id-2 or Amazon S3 extended request ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "signature_version",
+				Description: "The signature version, SigV2 or SigV4, that was used to authenticate the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cipher_suite",
+				Description: "The Transport Layer Security (TLS) cipher that was negotiated for an HTTPS request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication_type",
+				Description: "The type of request authentication used, AuthHeader for authentication headers or QueryString for query strings (presigned URLs).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_header",
+				Description: "The endpoint used to connect to Amazon S3.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tls_version",
+				Description: "The Transport Layer Security (TLS) version negotiated by the client.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_point_arn",
+				Description: "The Amazon Resource Name (ARN) of the access point of the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "acl_required",
+				Description: "Indicates whether the request required an access control list (ACL) for authorization.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "log_bucket_name",
+				Description: "The name of the bucket the access log was delivered to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_object_key",
+				Description: "The key of the log object the record was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The raw access log record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestId"),
+			},
+		}),
+	}
+}
+
+type s3BucketAccessLogRecord struct {
+	BucketName         *string
+	Timestamp          *time.Time
+	BucketOwner        *string
+	RemoteIp           *string
+	Requester          *string
+	RequestId          *string
+	Operation          *string
+	Key                *string
+	RequestUri         *string
+	HttpStatus         *int64
+	ErrorCode          *string
+	BytesSent          *int64
+	ObjectSize         *int64
+	TotalTime          *int64
+	TurnAroundTime     *int64
+	Referer            *string
+	UserAgent          *string
+	VersionId          *string
+	HostId             *string
+	SignatureVersion   *string
+	CipherSuite        *string
+	AuthenticationType *string
+	HostHeader         *string
+	TlsVersion         *string
+	AccessPointArn     *string
+	AclRequired        *bool
+	LogBucketName      *string
+	LogObjectKey       *string
+	Message            string
+	Region             string
+}
+
+// s3AccessLogTarget is a bucket and prefix that receives the access logs of
+// one or more source buckets, keyed by source bucket name.
+type s3AccessLogTarget struct {
+	Bucket  string
+	Prefix  string
+	Sources map[string]string
+}
+
+//// LIST FUNCTION
+
+func listS3BucketAccessLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketNames := []string{}
+	if d.EqualsQualString("bucket_name") != "" {
+		bucketNames = append(bucketNames, d.EqualsQualString("bucket_name"))
+	} else {
+		defaultRegion, err := getLastResortRegion(ctx, d, h)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := S3Client(ctx, d, defaultRegion)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogs", "get_client_error", err, "defaultRegion", defaultRegion)
+			return nil, err
+		}
+		bucketsResult, err := svc.ListBuckets(ctx, &s3.ListBucketsInput{})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogs", "api_error", err, "defaultRegion", defaultRegion)
+			return nil, err
+		}
+		for _, bucket := range bucketsResult.Buckets {
+			bucketNames = append(bucketNames, *bucket.Name)
+		}
+	}
+
+	// Several buckets may deliver their access logs to the same location, so
+	// group them to read each log object only once
+	targets := map[string]*s3AccessLogTarget{}
+	targetKeys := []string{}
+	for _, bucketName := range bucketNames {
+		bucketRegion, err := s3BucketRegion(ctx, d, h, bucketName)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogs", "bucket_name", bucketName, "api_error", err)
+			return nil, err
+		}
+		svc, err := S3Client(ctx, d, bucketRegion)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogs", "client_error", err)
+			return nil, err
+		}
+		logging, err := svc.GetBucketLogging(ctx, &s3.GetBucketLoggingInput{Bucket: aws.String(bucketName)})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogs", "bucket_name", bucketName, "api_error", err)
+			return nil, err
+		}
+		if logging.LoggingEnabled == nil || logging.LoggingEnabled.TargetBucket == nil {
+			continue
+		}
+
+		targetKey := *logging.LoggingEnabled.TargetBucket + "/" + aws.ToString(logging.LoggingEnabled.TargetPrefix)
+		if targets[targetKey] == nil {
+			targets[targetKey] = &s3AccessLogTarget{
+				Bucket:  *logging.LoggingEnabled.TargetBucket,
+				Prefix:  aws.ToString(logging.LoggingEnabled.TargetPrefix),
+				Sources: map[string]string{},
+			}
+			targetKeys = append(targetKeys, targetKey)
+		}
+		targets[targetKey].Sources[bucketName] = bucketRegion
+	}
+
+	for _, targetKey := range targetKeys {
+		if err := listS3BucketAccessLogsFromTarget(ctx, d, h, targets[targetKey]); err != nil {
+			return nil, err
+		}
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listS3BucketAccessLogsFromTarget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, target *s3AccessLogTarget) error {
+	svc, err := s3ClientForBucket(ctx, d, h, target.Bucket)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogsFromTarget", "log_bucket_name", target.Bucket, "client_error", err)
+		return err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return err
+	}
+	accountId := commonData.(*awsCommonColumnData).AccountId
+
+	// Log objects are keyed by delivery time, which can be up to a few hours
+	// after the requests they contain
+	startTime, endTime := timestampQualRange(d.Quals)
+	if endTime != nil {
+		deliveryEndTime := endTime.Add(24 * time.Hour)
+		endTime = &deliveryEndTime
+	}
+
+	// Objects are keyed either as <prefix>yyyy-mm-dd-hh-mm-ss-<id>, or with
+	// date-based partitioning as
+	// <prefix><account>/<region>/<bucket>/yyyy/mm/dd/yyyy-mm-dd-hh-mm-ss-<id>
+	objectPrefixes := []string{}
+	simpleFormat := false
+	for bucketName, bucketRegion := range target.Sources {
+		partitionPrefix := target.Prefix + accountId + "/" + bucketRegion + "/" + bucketName + "/"
+		output, err := svc.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:  aws.String(target.Bucket),
+			Prefix:  aws.String(partitionPrefix),
+			MaxKeys: 1,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogsFromTarget", "log_bucket_name", target.Bucket, "api_error", err)
+			return err
+		}
+		if len(output.Contents) > 0 {
+			objectPrefixes = append(objectPrefixes, s3DailyLogPrefixes(partitionPrefix, "2006/01/02/", startTime, endTime)...)
+		} else {
+			simpleFormat = true
+		}
+	}
+	if simpleFormat {
+		objectPrefixes = append(objectPrefixes, s3DailyLogPrefixes(target.Prefix, "2006-01-02-", startTime, endTime)...)
+	}
+
+	for _, objectPrefix := range objectPrefixes {
+		err := streamS3LogObjectLines(ctx, d, svc, target.Bucket, objectPrefix, func(key string, line string) bool {
+			record := parseS3AccessLogRecord(line)
+			if record == nil {
+				plugin.Logger(ctx).Debug("aws_s3_bucket_access_log.listS3BucketAccessLogsFromTarget", "key", key, "parse_error", "unexpected format")
+				return true
+			}
+			// The log location may also receive the logs of buckets that weren't requested
+			bucketRegion, ok := target.Sources[aws.ToString(record.BucketName)]
+			if !ok {
+				return true
+			}
+			record.Region = bucketRegion
+			record.LogBucketName = aws.String(target.Bucket)
+			record.LogObjectKey = aws.String(key)
+
+			d.StreamListItem(ctx, record)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.RowsRemaining(ctx) != 0
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_access_log.listS3BucketAccessLogsFromTarget", "log_bucket_name", target.Bucket, "prefix", objectPrefix, "api_error", err)
+			return err
+		}
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+//// UTILITY FUNCTIONS
+
+// parseS3AccessLogRecord parses a record in the S3 server access log format.
+// Fields are space separated, with the time in [] and the request URI,
+// referer and user agent in quotes. A - indicates an unknown or empty value.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/LogFormat.html
+func parseS3AccessLogRecord(line string) *s3BucketAccessLogRecord {
+	fields := splitS3AccessLogFields(line)
+	// Fields are only ever added to the end of the format, so older records
+	// have fewer of them
+	if len(fields) < 18 {
+		return nil
+	}
+	for len(fields) < 26 {
+		fields = append(fields, "-")
+	}
+
+	field := func(i int) *string {
+		if fields[i] == "-" || fields[i] == "" {
+			return nil
+		}
+		return aws.String(fields[i])
+	}
+	intField := func(i int) *int64 {
+		v, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil
+		}
+		return aws.Int64(v)
+	}
+
+	record := &s3BucketAccessLogRecord{
+		BucketOwner:        field(0),
+		BucketName:         field(1),
+		RemoteIp:           field(3),
+		Requester:          field(4),
+		RequestId:          field(5),
+		Operation:          field(6),
+		Key:                field(7),
+		RequestUri:         field(8),
+		HttpStatus:         intField(9),
+		ErrorCode:          field(10),
+		BytesSent:          intField(11),
+		ObjectSize:         intField(12),
+		TotalTime:          intField(13),
+		TurnAroundTime:     intField(14),
+		Referer:            field(15),
+		UserAgent:          field(16),
+		VersionId:          field(17),
+		HostId:             field(18),
+		SignatureVersion:   field(19),
+		CipherSuite:        field(20),
+		AuthenticationType: field(21),
+		HostHeader:         field(22),
+		TlsVersion:         field(23),
+		AccessPointArn:     field(24),
+		Message:            line,
+	}
+	if t, err := time.Parse("02/Jan/2006:15:04:05 -0700", fields[2]); err == nil {
+		record.Timestamp = &t
+	}
+	if fields[25] != "-" {
+		record.AclRequired = aws.Bool(fields[25] == "Yes")
+	}
+
+	return record
+}
+
+func splitS3AccessLogFields(line string) []string {
+	fields := []string{}
+
+	for i := 0; i < len(line); {
+		switch line[i] {
+		case ' ':
+			i++
+		case '[', '"':
+			closing := byte(']')
+			if line[i] == '"' {
+				closing = '"'
+			}
+			end := strings.IndexByte(line[i+1:], closing)
+			if end < 0 {
+				fields = append(fields, line[i+1:])
+				return fields
+			}
+			fields = append(fields, line[i+1:i+1+end])
+			i += end + 2
+		default:
+			end := strings.IndexByte(line[i:], ' ')
+			if end < 0 {
+				fields = append(fields, line[i:])
+				return fields
+			}
+			fields = append(fields, line[i:i+end])
+			i += end
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestParseS3AccessLogRecord(t *testing.T) {
+	line := `79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be awsexamplebucket1 [06/Feb/2019:00:00:38 +0000] 192.0.2.3 arn:aws:iam::123456789012:user/alice 3E57427F3EXAMPLE REST.GET.VERSIONING - "GET /awsexamplebucket1?versioning HTTP/1.1" 200 - 113 - 7 - "-" "S3Console/0.4" - s9lzHYrFp76ZVxRcpX9+5cjAnEH2ROuNkd2BHfIa6UkFVdtjf5mKR3/eTPFvsiP/XV/VLi31234= SigV4 ECDHE-RSA-AES128-GCM-SHA256 AuthHeader awsexamplebucket1.s3.us-west-1.amazonaws.com TLSV1.2 arn:aws:s3:us-west-1:123456789012:accesspoint/example-AP Yes`
+
+	record := parseS3AccessLogRecord(line)
+	if record == nil {
+		t.Fatal("expected record, got nil")
+	}
+	if *record.BucketName != "awsexamplebucket1" || *record.Requester != "arn:aws:iam::123456789012:user/alice" || *record.Operation != "REST.GET.VERSIONING" {
+		t.Errorf("unexpected fields: %+v", record)
+	}
+	if record.Key != nil || record.Referer != nil || record.ObjectSize != nil {
+		t.Errorf("expected - values to be nil: %+v", record)
+	}
+	if *record.RequestUri != "GET /awsexamplebucket1?versioning HTTP/1.1" || *record.UserAgent != "S3Console/0.4" {
+		t.Errorf("unexpected quoted fields: %q %q", *record.RequestUri, *record.UserAgent)
+	}
+	if *record.HttpStatus != 200 || *record.BytesSent != 113 || *record.TotalTime != 7 {
+		t.Errorf("unexpected numeric fields: %+v", record)
+	}
+	if record.Timestamp == nil || record.Timestamp.Unix() != 1549411238 {
+		t.Errorf("unexpected timestamp: %v", record.Timestamp)
+	}
+	if *record.TlsVersion != "TLSV1.2" || !*record.AclRequired {
+		t.Errorf("unexpected trailing fields: %+v", record)
+	}
+
+	if record := parseS3AccessLogRecord("not an access log"); record != nil {
+		t.Errorf("expected nil, got: %+v", record)
+	}
+}
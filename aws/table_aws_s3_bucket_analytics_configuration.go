@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketAnalyticsConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_analytics_configuration",
+		Description: "AWS S3 Bucket Analytics Configuration",
+		Get: &plugin.GetConfig{
+			Hydrate:    getBucketAnalyticsConfiguration,
+			Tags:       map[string]string{"service": "s3", "action": "GetBucketAnalyticsConfiguration"},
+			KeyColumns: plugin.AllColumns([]string{"bucket_name", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchConfiguration", "NoSuchBucket"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketAnalyticsConfigurations,
+			Tags:          map[string]string{"service": "s3", "action": "ListBucketAnalyticsConfigurations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket the analytics configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID used to identify the analytics configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "The filter used to describe a set of objects for analyses. If no filter is provided, all objects will be considered in any analysis.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(s3BucketAnalyticsFilterToMap),
+			},
+			{
+				Name:        "storage_class_analysis",
+				Description: "Contains data related to access patterns to be collected and made available to analyze the tradeoffs between different storage classes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "data_export_output_schema_version",
+				Description: "The version of the output schema to use when exporting data.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageClassAnalysis.DataExport.OutputSchemaVersion"),
+			},
+			{
+				Name:        "data_export_bucket_arn",
+				Description: "The Amazon Resource Name (ARN) of the bucket to which data is exported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Bucket"),
+			},
+			{
+				Name:        "data_export_prefix",
+				Description: "The prefix to use when exporting data.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Prefix"),
+			},
+			{
+				Name:        "data_export_format",
+				Description: "Specifies the file format used when exporting data.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Format"),
+			},
+			{
+				Name:        "data_export_bucket_account_id",
+				Description: "The account ID that owns the destination S3 bucket.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageClassAnalysis.DataExport.Destination.S3BucketDestination.BucketAccountId"),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type AnalyticsConfigurationInfo struct {
+	BucketName *string
+	Region     string
+	types.AnalyticsConfiguration
+}
+
+//// LIST FUNCTION
+
+func listBucketAnalyticsConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_analytics_configuration.listBucketAnalyticsConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.ListBucketAnalyticsConfigurationsInput{
+		Bucket: bucket.Name,
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		op, err := svc.ListBucketAnalyticsConfigurations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_analytics_configuration.listBucketAnalyticsConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range op.AnalyticsConfigurationList {
+			d.StreamListItem(ctx, &AnalyticsConfigurationInfo{bucket.Name, region, configuration})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextContinuationToken == nil {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBucketAnalyticsConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := d.EqualsQualString("bucket_name")
+	id := d.EqualsQualString("id")
+
+	if bucketName == "" || id == "" {
+		return nil, nil
+	}
+
+	region, err := s3BucketRegion(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_analytics_configuration.getBucketAnalyticsConfiguration", "bucket_name", bucketName, "api_error", err)
+		return nil, err
+	}
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_analytics_configuration.getBucketAnalyticsConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketAnalyticsConfigurationInput{
+		Bucket: &bucketName,
+		Id:     &id,
+	}
+
+	op, err := svc.GetBucketAnalyticsConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_analytics_configuration.getBucketAnalyticsConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if op != nil && op.AnalyticsConfiguration != nil {
+		return &AnalyticsConfigurationInfo{&bucketName, region, *op.AnalyticsConfiguration}, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The filter is a union, so return it keyed by the member that is set, e.g.
+// {"Prefix": "logs/"}
+func s3BucketAnalyticsFilterToMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch f := d.HydrateItem.(*AnalyticsConfigurationInfo).Filter.(type) {
+	case *types.AnalyticsFilterMemberAnd:
+		return map[string]interface{}{"And": f.Value}, nil
+	case *types.AnalyticsFilterMemberPrefix:
+		return map[string]interface{}{"Prefix": f.Value}, nil
+	case *types.AnalyticsFilterMemberTag:
+		return map[string]interface{}{"Tag": f.Value}, nil
+	}
+	return nil, nil
+}
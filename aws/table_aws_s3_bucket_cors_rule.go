@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketCorsRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_cors_rule",
+		Description: "AWS S3 Bucket CORS Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketCorsRules,
+			Tags:          map[string]string{"service": "s3", "action": "GetBucketCors"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket the CORS rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Unique identifier for the rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "allowed_methods",
+				Description: "An HTTP method that you allow the origin to run, GET, PUT, HEAD, POST or DELETE.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_origins",
+				Description: "One or more origins you want customers to be able to access the bucket from.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_headers",
+				Description: "Headers that are specified in the Access-Control-Request-Headers header.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "expose_headers",
+				Description: "One or more headers in the response that you want customers to be able to access from their applications.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "max_age_seconds",
+				Description: "The time in seconds that your browser is to cache the preflight response for the specified resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID", "BucketName"),
+			},
+		}),
+	}
+}
+
+type CorsRuleInfo struct {
+	BucketName *string
+	Region     string
+	types.CORSRule
+}
+
+//// LIST FUNCTION
+
+func listBucketCorsRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_cors_rule.listBucketCorsRules", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketCorsInput{
+		Bucket: bucket.Name,
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	op, err := svc.GetBucketCors(ctx, params)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NoSuchCORSConfiguration" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_s3_bucket_cors_rule.listBucketCorsRules", "api_error", err)
+		return nil, err
+	}
+
+	for _, rule := range op.CORSRules {
+		d.StreamListItem(ctx, &CorsRuleInfo{bucket.Name, region, rule})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
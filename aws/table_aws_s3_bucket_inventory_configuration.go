@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketInventoryConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_inventory_configuration",
+		Description: "AWS S3 Bucket Inventory Configuration",
+		Get: &plugin.GetConfig{
+			Hydrate:    getBucketInventoryConfiguration,
+			Tags:       map[string]string{"service": "s3", "action": "GetBucketInventoryConfiguration"},
+			KeyColumns: plugin.AllColumns([]string{"bucket_name", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchConfiguration", "NoSuchBucket"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketInventoryConfigurations,
+			Tags:          map[string]string{"service": "s3", "action": "ListBucketInventoryConfigurations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket the inventory configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID used to identify the inventory configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Specifies whether the inventory is enabled or disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "included_object_versions",
+				Description: "Object versions to include in the inventory list, All or Current.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule_frequency",
+				Description: "Specifies how frequently inventory results are produced, Daily or Weekly.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Schedule.Frequency"),
+			},
+			{
+				Name:        "filter_prefix",
+				Description: "The prefix that an object must have to be included in the inventory results.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Filter.Prefix"),
+			},
+			{
+				Name:        "destination_bucket_arn",
+				Description: "The Amazon Resource Name (ARN) of the bucket where inventory results will be published.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.S3BucketDestination.Bucket"),
+			},
+			{
+				Name:        "destination_prefix",
+				Description: "The prefix that is prepended to all inventory results.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.S3BucketDestination.Prefix"),
+			},
+			{
+				Name:        "destination_format",
+				Description: "Specifies the output format of the inventory results, CSV, ORC or Parquet.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.S3BucketDestination.Format"),
+			},
+			{
+				Name:        "destination_account_id",
+				Description: "The account ID that owns the destination S3 bucket.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination.S3BucketDestination.AccountId"),
+			},
+			{
+				Name:        "destination_encryption",
+				Description: "Contains the type of server-side encryption used to encrypt the inventory results.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Destination.S3BucketDestination.Encryption"),
+			},
+			{
+				Name:        "optional_fields",
+				Description: "Contains the optional fields that are included in the inventory results.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type InventoryConfigurationInfo struct {
+	BucketName *string
+	Region     string
+	types.InventoryConfiguration
+}
+
+//// LIST FUNCTION
+
+func listBucketInventoryConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_inventory_configuration.listBucketInventoryConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.ListBucketInventoryConfigurationsInput{
+		Bucket: bucket.Name,
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		op, err := svc.ListBucketInventoryConfigurations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_inventory_configuration.listBucketInventoryConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range op.InventoryConfigurationList {
+			d.StreamListItem(ctx, &InventoryConfigurationInfo{bucket.Name, region, configuration})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextContinuationToken == nil {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBucketInventoryConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := d.EqualsQualString("bucket_name")
+	id := d.EqualsQualString("id")
+
+	if bucketName == "" || id == "" {
+		return nil, nil
+	}
+
+	region, err := s3BucketRegion(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_inventory_configuration.getBucketInventoryConfiguration", "bucket_name", bucketName, "api_error", err)
+		return nil, err
+	}
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_inventory_configuration.getBucketInventoryConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketInventoryConfigurationInput{
+		Bucket: &bucketName,
+		Id:     &id,
+	}
+
+	op, err := svc.GetBucketInventoryConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_inventory_configuration.getBucketInventoryConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if op != nil && op.InventoryConfiguration != nil {
+		return &InventoryConfigurationInfo{&bucketName, region, *op.InventoryConfiguration}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketMetricsConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_metrics_configuration",
+		Description: "AWS S3 Bucket Metrics Configuration",
+		Get: &plugin.GetConfig{
+			Hydrate:    getBucketMetricsConfiguration,
+			Tags:       map[string]string{"service": "s3", "action": "GetBucketMetricsConfiguration"},
+			KeyColumns: plugin.AllColumns([]string{"bucket_name", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchConfiguration", "NoSuchBucket"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketMetricsConfigurations,
+			Tags:          map[string]string{"service": "s3", "action": "ListBucketMetricsConfigurations"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket the metrics configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID used to identify the metrics configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "Specifies a metrics configuration filter. The metrics configuration will only include objects that meet the filter's criteria.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(s3BucketMetricsFilterToMap),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type MetricsConfigurationInfo struct {
+	BucketName *string
+	Region     string
+	types.MetricsConfiguration
+}
+
+//// LIST FUNCTION
+
+func listBucketMetricsConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_metrics_configuration.listBucketMetricsConfigurations", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.ListBucketMetricsConfigurationsInput{
+		Bucket: bucket.Name,
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		op, err := svc.ListBucketMetricsConfigurations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_bucket_metrics_configuration.listBucketMetricsConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range op.MetricsConfigurationList {
+			d.StreamListItem(ctx, &MetricsConfigurationInfo{bucket.Name, region, configuration})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if op.NextContinuationToken == nil {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBucketMetricsConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := d.EqualsQualString("bucket_name")
+	id := d.EqualsQualString("id")
+
+	if bucketName == "" || id == "" {
+		return nil, nil
+	}
+
+	region, err := s3BucketRegion(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_metrics_configuration.getBucketMetricsConfiguration", "bucket_name", bucketName, "api_error", err)
+		return nil, err
+	}
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_metrics_configuration.getBucketMetricsConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketMetricsConfigurationInput{
+		Bucket: &bucketName,
+		Id:     &id,
+	}
+
+	op, err := svc.GetBucketMetricsConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_metrics_configuration.getBucketMetricsConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if op != nil && op.MetricsConfiguration != nil {
+		return &MetricsConfigurationInfo{&bucketName, region, *op.MetricsConfiguration}, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The filter is a union, so return it keyed by the member that is set, e.g.
+// {"Prefix": "logs/"}
+func s3BucketMetricsFilterToMap(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch f := d.HydrateItem.(*MetricsConfigurationInfo).Filter.(type) {
+	case *types.MetricsFilterMemberAccessPointArn:
+		return map[string]interface{}{"AccessPointArn": f.Value}, nil
+	case *types.MetricsFilterMemberAnd:
+		return map[string]interface{}{"And": f.Value}, nil
+	case *types.MetricsFilterMemberPrefix:
+		return map[string]interface{}{"Prefix": f.Value}, nil
+	case *types.MetricsFilterMemberTag:
+		return map[string]interface{}{"Tag": f.Value}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3BucketRequestPayment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_bucket_request_payment",
+		Description: "AWS S3 Bucket Request Payment Configuration",
+		List: &plugin.ListConfig{
+			ParentHydrate: listS3Buckets,
+			Hydrate:       listBucketRequestPayments,
+			Tags:          map[string]string{"service": "s3", "action": "GetBucketRequestPayment"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payer",
+				Description: "Specifies who pays for the download and request fees, BucketOwner or Requester.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the bucket is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BucketName"),
+			},
+		}),
+	}
+}
+
+type RequestPaymentInfo struct {
+	BucketName *string
+	Region     string
+	Payer      types.Payer
+}
+
+//// LIST FUNCTION
+
+func listBucketRequestPayments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(types.Bucket)
+
+	if d.EqualsQualString("bucket_name") != "" && d.EqualsQualString("bucket_name") != *bucket.Name {
+		return nil, nil
+	}
+
+	// Bucket location will be nil if getBucketLocation returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocation(ctx, d, h)
+	if err != nil {
+		return nil, nil
+	} else if location == nil {
+		return nil, nil
+	}
+	region := string(location.(*s3.GetBucketLocationOutput).LocationConstraint)
+
+	// Create client
+	svc, err := S3Client(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_request_payment.listBucketRequestPayments", "client_error", err)
+		return nil, err
+	}
+
+	params := &s3.GetBucketRequestPaymentInput{
+		Bucket: bucket.Name,
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	op, err := svc.GetBucketRequestPayment(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_bucket_request_payment.listBucketRequestPayments", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, &RequestPaymentInfo{bucket.Name, region, op.Payer})
+
+	return nil, nil
+}
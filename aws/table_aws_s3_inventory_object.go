@@ -0,0 +1,581 @@
+package aws
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/scritchley/orc"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Inventory reports are delivered to folders named after the time the report
+// was created, e.g. 2023-10-10T01-00Z/
+const s3InventoryReportDateLayout = "2006-01-02T15-04Z"
+
+//// TABLE DEFINITION
+
+func tableAwsS3InventoryObject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_inventory_object",
+		Description: "List the objects of an AWS S3 bucket from its latest S3 Inventory report.",
+		List: &plugin.ListConfig{
+			Hydrate: listS3InventoryObjects,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "inventory_id", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "report_date", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket the inventory report lists.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "inventory_id",
+				Description: "The ID of the inventory configuration that produced the report.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_date",
+				Description: "The UTC day the inventory report was created. Defaults to the latest report, or the latest report on the given day if specified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("report_date").TransformP(qualValueOrField, "ReportDate"),
+			},
+			{
+				Name:        "report_created_at",
+				Description: "The time the inventory report was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "data_file_key",
+				Description: "The key of the inventory data file the row was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The object key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_id",
+				Description: "The object version ID, if the inventory includes all object versions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_latest",
+				Description: "True if the object version is the current version of the object.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_delete_marker",
+				Description: "True if the object version is a delete marker.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "size",
+				Description: "The object size in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The object creation date or the last modified date, whichever is the latest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag is a hash of the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "storage_class",
+				Description: "The storage class used for storing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_multipart_uploaded",
+				Description: "True if the object was uploaded as a multipart upload.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "replication_status",
+				Description: "The replication status of the object, e.g. PENDING, COMPLETED, FAILED or REPLICA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "encryption_status",
+				Description: "The server-side encryption used to encrypt the object, e.g. SSE-S3, SSE-KMS or NOT-SSE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_lock_retain_until_date",
+				Description: "The date until which the locked object cannot be deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "object_lock_mode",
+				Description: "The retention mode of a locked object, GOVERNANCE or COMPLIANCE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_lock_legal_hold_status",
+				Description: "The legal hold status of a locked object, ON or OFF.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "intelligent_tiering_access_tier",
+				Description: "The access tier of an object stored in the S3 Intelligent-Tiering storage class.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket_key_status",
+				Description: "Whether an S3 Bucket Key is used to encrypt the object, ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "checksum_algorithm",
+				Description: "The algorithm used to create a checksum for the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_owner",
+				Description: "The canonical user ID of the object owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type s3InventoryObject struct {
+	BucketName                   *string
+	InventoryId                  *string
+	ReportDate                   *time.Time
+	ReportCreatedAt              *time.Time
+	DataFileKey                  *string
+	Key                          *string
+	VersionId                    *string
+	IsLatest                     *bool
+	IsDeleteMarker               *bool
+	Size                         *int64
+	LastModifiedDate             *time.Time
+	ETag                         *string
+	StorageClass                 *string
+	IsMultipartUploaded          *bool
+	ReplicationStatus            *string
+	EncryptionStatus             *string
+	ObjectLockRetainUntilDate    *time.Time
+	ObjectLockMode               *string
+	ObjectLockLegalHoldStatus    *string
+	IntelligentTieringAccessTier *string
+	BucketKeyStatus              *string
+	ChecksumAlgorithm            *string
+	ObjectOwner                  *string
+}
+
+// s3InventoryManifest is the manifest.json delivered with each inventory report.
+type s3InventoryManifest struct {
+	SourceBucket      string `json:"sourceBucket"`
+	DestinationBucket string `json:"destinationBucket"`
+	FileFormat        string `json:"fileFormat"`
+	FileSchema        string `json:"fileSchema"`
+	Files             []struct {
+		Key  string `json:"key"`
+		Size int64  `json:"size"`
+	} `json:"files"`
+}
+
+//// LIST FUNCTION
+
+func listS3InventoryObjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketName := d.EqualsQualString("bucket_name")
+
+	svc, err := s3ClientForBucket(ctx, d, h, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_inventory_object.listS3InventoryObjects", "bucket_name", bucketName, "client_error", err)
+		return nil, err
+	}
+
+	configurations, err := listS3InventoryConfigurations(ctx, d, svc, bucketName)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_inventory_object.listS3InventoryObjects", "bucket_name", bucketName, "api_error", err)
+		return nil, err
+	}
+
+	for _, configuration := range configurations {
+		more, err := readS3InventoryReport(ctx, d, h, bucketName, configuration)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_inventory_object.listS3InventoryObjects", "bucket_name", bucketName, "inventory_id", *configuration.Id, "api_error", err)
+			return nil, err
+		}
+		if !more {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// listS3InventoryConfigurations returns the inventory configurations of the
+// source bucket matching the inventory_id qual, or every enabled configuration
+// if it isn't specified.
+func listS3InventoryConfigurations(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string) ([]types.InventoryConfiguration, error) {
+	inventoryId := d.EqualsQualString("inventory_id")
+
+	configurations := []types.InventoryConfiguration{}
+	params := &s3.ListBucketInventoryConfigurationsInput{
+		Bucket: aws.String(bucketName),
+	}
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		op, err := svc.ListBucketInventoryConfigurations(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, configuration := range op.InventoryConfigurationList {
+			if configuration.Destination == nil || configuration.Destination.S3BucketDestination == nil {
+				continue
+			}
+			// Reports of a disabled configuration are still readable when it is
+			// requested explicitly
+			if inventoryId != "" {
+				if *configuration.Id == inventoryId {
+					configurations = append(configurations, configuration)
+				}
+			} else if configuration.IsEnabled {
+				configurations = append(configurations, configuration)
+			}
+		}
+
+		if op.NextContinuationToken == nil {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return configurations, nil
+}
+
+// readS3InventoryReport streams the objects listed in the selected report of an
+// inventory configuration, returning false once no more rows are needed.
+func readS3InventoryReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, bucketName string, configuration types.InventoryConfiguration) (bool, error) {
+	destination := configuration.Destination.S3BucketDestination
+	destinationBucket := s3BucketNameFromArn(*destination.Bucket)
+
+	svc, err := s3ClientForBucket(ctx, d, h, destinationBucket)
+	if err != nil {
+		return false, err
+	}
+
+	// Reports are delivered to <prefix>/<source bucket>/<configuration ID>/
+	reportPrefix := s3InventoryReportPrefix(aws.ToString(destination.Prefix), bucketName, *configuration.Id)
+	prefixes, err := listS3CommonPrefixes(ctx, d, svc, destinationBucket, reportPrefix)
+	if err != nil {
+		return false, err
+	}
+
+	var reportDate *time.Time
+	if d.EqualsQuals["report_date"] != nil {
+		reportDate = aws.Time(d.EqualsQuals["report_date"].GetTimestampValue().AsTime())
+	}
+	folder, createdAt := latestS3InventoryReportFolder(prefixes, reportPrefix, reportDate)
+	if folder == "" {
+		plugin.Logger(ctx).Debug("aws_s3_inventory_object.readS3InventoryReport", "inventory_id", *configuration.Id, "no report found under", reportPrefix)
+		return true, nil
+	}
+
+	manifest, err := getS3InventoryManifest(ctx, svc, destinationBucket, folder+"manifest.json")
+	if err != nil {
+		return false, err
+	}
+
+	schema := []string{}
+	for _, field := range strings.Split(manifest.FileSchema, ",") {
+		schema = append(schema, normalizeS3InventoryField(field))
+	}
+
+	for _, file := range manifest.Files {
+		stream := func(fields map[string]interface{}) bool {
+			item := newS3InventoryObject(fields)
+			item.BucketName = aws.String(bucketName)
+			item.InventoryId = configuration.Id
+			item.ReportDate = aws.Time(createdAt.Truncate(24 * time.Hour))
+			item.ReportCreatedAt = createdAt
+			item.DataFileKey = aws.String(file.Key)
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			return d.RowsRemaining(ctx) != 0
+		}
+
+		var more bool
+		switch strings.ToUpper(manifest.FileFormat) {
+		case "CSV":
+			more, err = readS3InventoryCsvFile(ctx, svc, destinationBucket, file.Key, schema, stream)
+		case "ORC":
+			more, err = readS3InventoryOrcFile(ctx, svc, destinationBucket, file.Key, file.Size, stream)
+		case "PARQUET":
+			more, err = readS3InventoryParquetFile(ctx, svc, destinationBucket, file.Key, file.Size, stream)
+		default:
+			return false, fmt.Errorf("inventory %s uses the unsupported %s format", *configuration.Id, manifest.FileFormat)
+		}
+		if err != nil || !more {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func getS3InventoryManifest(ctx context.Context, svc *s3.Client, bucketName string, key string) (*s3InventoryManifest, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	manifest := &s3InventoryManifest{}
+	if err := json.NewDecoder(object.Body).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory manifest %s: %v", key, err)
+	}
+	return manifest, nil
+}
+
+// readS3InventoryCsvFile reads a gzipped CSV data file, whose columns are
+// listed by the manifest schema as there is no header line.
+func readS3InventoryCsvFile(ctx context.Context, svc *s3.Client, bucketName string, key string, schema []string, fn func(fields map[string]interface{}) bool) (bool, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer object.Body.Close()
+
+	reader, err := newS3ObjectContentReader(object.Body)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	for {
+		values, err := csvReader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		fields := map[string]interface{}{}
+		for i, value := range values {
+			if i < len(schema) {
+				fields[schema[i]] = value
+			}
+		}
+		// Object keys are URL encoded in CSV reports
+		if key, ok := fields["key"].(string); ok {
+			if decoded, err := url.QueryUnescape(key); err == nil {
+				fields["key"] = decoded
+			}
+		}
+
+		if !fn(fields) {
+			return false, nil
+		}
+	}
+}
+
+// readS3InventoryOrcFile reads an ORC data file with ranged requests, as the
+// file footer is needed to decode its stripes.
+func readS3InventoryOrcFile(ctx context.Context, svc *s3.Client, bucketName string, key string, size int64, fn func(fields map[string]interface{}) bool) (bool, error) {
+	return readS3InventoryOrcRows(&s3ObjectRangeReader{
+		ctx:    ctx,
+		svc:    svc,
+		bucket: bucketName,
+		key:    key,
+		size:   size,
+	}, fn)
+}
+
+// readS3InventoryOrcRows reads the rows of an ORC data file, whose typed values
+// are keyed by the field names of the file schema.
+func readS3InventoryOrcRows(file orc.SizedReaderAt, fn func(fields map[string]interface{}) bool) (bool, error) {
+	reader, err := orc.NewReader(file)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	columns := reader.Schema().Columns()
+	cursor := reader.Select(columns...)
+	for cursor.Stripes() {
+		for cursor.Next() {
+			fields := map[string]interface{}{}
+			for i, value := range cursor.Row() {
+				fields[normalizeS3InventoryField(columns[i])] = value
+			}
+			if !fn(fields) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, cursor.Err()
+}
+
+// readS3InventoryParquetFile reads a Parquet data file with ranged requests.
+func readS3InventoryParquetFile(ctx context.Context, svc *s3.Client, bucketName string, key string, size int64, fn func(fields map[string]interface{}) bool) (bool, error) {
+	return readS3ParquetFile(ctx, svc, bucketName, key, size, func(record map[string]interface{}) bool {
+		fields := map[string]interface{}{}
+		for name, value := range record {
+			fields[normalizeS3InventoryField(name)] = value
+		}
+		return fn(fields)
+	})
+}
+
+//// UTILITY FUNCTIONS
+
+// s3BucketNameFromArn returns the bucket name of an ARN such as
+// arn:aws:s3:::my-bucket.
+func s3BucketNameFromArn(bucketArn string) string {
+	return bucketArn[strings.LastIndex(bucketArn, ":")+1:]
+}
+
+func s3InventoryReportPrefix(destinationPrefix string, bucketName string, inventoryId string) string {
+	prefix := bucketName + "/" + inventoryId + "/"
+	if destinationPrefix = strings.Trim(destinationPrefix, "/"); destinationPrefix != "" {
+		prefix = destinationPrefix + "/" + prefix
+	}
+	return prefix
+}
+
+// latestS3InventoryReportFolder returns the most recent report folder below the
+// report prefix, and the time it was created. If day is set only reports created
+// on the same UTC day are considered. Other folders, e.g. data/ and hive/, are
+// ignored.
+func latestS3InventoryReportFolder(prefixes []string, reportPrefix string, day *time.Time) (string, *time.Time) {
+	sort.Sort(sort.Reverse(sort.StringSlice(prefixes)))
+	for _, prefix := range prefixes {
+		name := strings.TrimSuffix(strings.TrimPrefix(prefix, reportPrefix), "/")
+		createdAt, err := time.Parse(s3InventoryReportDateLayout, name)
+		if err != nil {
+			continue
+		}
+		if day != nil && createdAt.Format("2006-01-02") != day.UTC().Format("2006-01-02") {
+			continue
+		}
+		return prefix, &createdAt
+	}
+	return "", nil
+}
+
+// normalizeS3InventoryField maps the field names of the CSV schema, e.g.
+// LastModifiedDate, and the Parquet schema, e.g. last_modified_date, to the
+// same name.
+func normalizeS3InventoryField(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+}
+
+// newS3InventoryObject builds a row from the fields of an inventory record.
+// CSV records contain only strings, while ORC and Parquet records contain Go
+// values.
+func newS3InventoryObject(fields map[string]interface{}) *s3InventoryObject {
+	return &s3InventoryObject{
+		Key:                          s3InventoryString(fields["key"]),
+		VersionId:                    s3InventoryString(fields["versionid"]),
+		IsLatest:                     s3InventoryBool(fields["islatest"]),
+		IsDeleteMarker:               s3InventoryBool(fields["isdeletemarker"]),
+		Size:                         s3InventoryInt64(fields["size"]),
+		LastModifiedDate:             s3InventoryTime(fields["lastmodifieddate"]),
+		ETag:                         s3InventoryString(fields["etag"]),
+		StorageClass:                 s3InventoryString(fields["storageclass"]),
+		IsMultipartUploaded:          s3InventoryBool(fields["ismultipartuploaded"]),
+		ReplicationStatus:            s3InventoryString(fields["replicationstatus"]),
+		EncryptionStatus:             s3InventoryString(fields["encryptionstatus"]),
+		ObjectLockRetainUntilDate:    s3InventoryTime(fields["objectlockretainuntildate"]),
+		ObjectLockMode:               s3InventoryString(fields["objectlockmode"]),
+		ObjectLockLegalHoldStatus:    s3InventoryString(fields["objectlocklegalholdstatus"]),
+		IntelligentTieringAccessTier: s3InventoryString(fields["intelligenttieringaccesstier"]),
+		BucketKeyStatus:              s3InventoryString(fields["bucketkeystatus"]),
+		ChecksumAlgorithm:            s3InventoryString(fields["checksumalgorithm"]),
+		ObjectOwner:                  s3InventoryString(fields["objectowner"]),
+	}
+}
+
+func s3InventoryString(value interface{}) *string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return aws.String(v)
+		}
+	case float64:
+		return aws.String(strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return nil
+}
+
+func s3InventoryBool(value interface{}) *bool {
+	switch v := value.(type) {
+	case bool:
+		return aws.Bool(v)
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return aws.Bool(b)
+		}
+	}
+	return nil
+}
+
+func s3InventoryInt64(value interface{}) *int64 {
+	switch v := value.(type) {
+	case int64:
+		return aws.Int64(v)
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return aws.Int64(i)
+		}
+	}
+	return nil
+}
+
+func s3InventoryTime(value interface{}) *time.Time {
+	switch v := value.(type) {
+	case time.Time:
+		return aws.Time(v.UTC())
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return aws.Time(t)
+		}
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return aws.Time(time.UnixMilli(ms).UTC())
+		}
+	}
+	return nil
+}
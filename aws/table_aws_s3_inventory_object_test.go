@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/scritchley/orc"
+)
+
+func TestLatestS3InventoryReportFolder(t *testing.T) {
+	reportPrefix := "inventory/my-bucket/daily/"
+	prefixes := []string{
+		reportPrefix + "2023-10-09T01-00Z/",
+		reportPrefix + "2023-10-11T01-00Z/",
+		reportPrefix + "2023-10-10T01-00Z/",
+		reportPrefix + "data/",
+		reportPrefix + "hive/",
+	}
+
+	folder, createdAt := latestS3InventoryReportFolder(prefixes, reportPrefix, nil)
+	if folder != reportPrefix+"2023-10-11T01-00Z/" {
+		t.Errorf("expected the latest report, got %q", folder)
+	}
+	if createdAt == nil || !createdAt.Equal(time.Date(2023, 10, 11, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected report date %v", createdAt)
+	}
+
+	day := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
+	if folder, _ := latestS3InventoryReportFolder(prefixes, reportPrefix, &day); folder != reportPrefix+"2023-10-10T01-00Z/" {
+		t.Errorf("expected the report on %s, got %q", day.Format("2006-01-02"), folder)
+	}
+
+	day = time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	if folder, _ := latestS3InventoryReportFolder(prefixes, reportPrefix, &day); folder != "" {
+		t.Errorf("expected no report on %s, got %q", day.Format("2006-01-02"), folder)
+	}
+}
+
+func TestNewS3InventoryObject(t *testing.T) {
+	// CSV schema field names with string values
+	csvObject := newS3InventoryObject(map[string]interface{}{
+		normalizeS3InventoryField("Key"):              "photos/cat.jpg",
+		normalizeS3InventoryField("Size"):             "1024",
+		normalizeS3InventoryField("IsLatest"):         "true",
+		normalizeS3InventoryField("LastModifiedDate"): "2023-10-10T12:00:00.000Z",
+		normalizeS3InventoryField("VersionId"):        "",
+	})
+	if *csvObject.Key != "photos/cat.jpg" || *csvObject.Size != 1024 || !*csvObject.IsLatest || csvObject.VersionId != nil {
+		t.Errorf("unexpected CSV object %+v", csvObject)
+	}
+	if !csvObject.LastModifiedDate.Equal(time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected CSV last modified date %v", csvObject.LastModifiedDate)
+	}
+
+	// Parquet schema field names with typed values
+	parquetObject := newS3InventoryObject(map[string]interface{}{
+		normalizeS3InventoryField("key"):                "photos/dog.jpg",
+		normalizeS3InventoryField("size"):               int64(2048),
+		normalizeS3InventoryField("is_latest"):          false,
+		normalizeS3InventoryField("last_modified_date"): time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC),
+	})
+	if *parquetObject.Key != "photos/dog.jpg" || *parquetObject.Size != 2048 || *parquetObject.IsLatest {
+		t.Errorf("unexpected Parquet object %+v", parquetObject)
+	}
+	if !parquetObject.LastModifiedDate.Equal(time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected Parquet last modified date %v", parquetObject.LastModifiedDate)
+	}
+}
+
+func TestReadS3InventoryOrcRows(t *testing.T) {
+	schema, err := orc.ParseSchema("struct<bucket:string,key:string,size:bigint,last_modified_date:timestamp,is_latest:boolean>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	w, err := orc.NewWriter(&buf, orc.SetSchema(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastModified := time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)
+	for _, key := range []string{"photos/cat.jpg", "photos/dog.jpg", "photos/owl.jpg"} {
+		if err := w.Write("my-bucket", key, int64(1024), lastModified, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := []*s3InventoryObject{}
+	more, err := readS3InventoryOrcRows(bytes.NewReader(buf.Bytes()), func(fields map[string]interface{}) bool {
+		objects = append(objects, newS3InventoryObject(fields))
+		return len(objects) < 2
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if more {
+		t.Errorf("expected reading to stop once no more rows are needed")
+	}
+	if len(objects) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(objects))
+	}
+	object := objects[1]
+	if *object.Key != "photos/dog.jpg" || *object.Size != 1024 || !*object.IsLatest {
+		t.Errorf("unexpected ORC object %+v", object)
+	}
+	if !object.LastModifiedDate.Equal(lastModified) {
+		t.Errorf("unexpected ORC last modified date %v", object.LastModifiedDate)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -26,6 +27,7 @@ func tableAwsS3Object(_ context.Context) *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "bucket_name", Require: plugin.Required, CacheMatch: "exact"},
 				{Name: "prefix", Require: plugin.Optional},
+				{Name: "key", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<", "<=", "~~"}},
 			},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
@@ -385,6 +387,48 @@ func tableAwsS3Object(_ context.Context) *plugin.Table {
 	}
 }
 
+// defaultS3ObjectListParallelism is the number of prefixes listed concurrently
+// when s3_object_list_parallelism isn't set in the connection config.
+const defaultS3ObjectListParallelism = 10
+
+// s3ObjectKeyRange is the range of keys requested by the key and prefix quals.
+// Keys are listed from StartAfter (exclusive) until End, in UTF-8 binary order.
+type s3ObjectKeyRange struct {
+	Prefix       string
+	StartAfter   string
+	End          string
+	EndInclusive bool
+	Empty        bool
+}
+
+// s3ObjectQuery is the part of the query data used to list objects, so the
+// listing can run against a fake query in tests.
+type s3ObjectQuery interface {
+	WaitForListRateLimit(ctx context.Context)
+	RowsRemaining(ctx context.Context) int64
+	StreamObject(ctx context.Context, object types.Object)
+}
+
+// s3ObjectQueryData streams the listed objects as rows of the query.
+type s3ObjectQueryData struct {
+	*plugin.QueryData
+}
+
+func (d s3ObjectQueryData) StreamObject(ctx context.Context, object types.Object) {
+	d.StreamListItem(ctx, object)
+}
+
+// s3ObjectStreamResult tells the listing of a prefix whether to continue.
+type s3ObjectStreamResult int
+
+const (
+	s3ObjectStreamMore s3ObjectStreamResult = iota
+	// The remaining keys are after the end of the key range
+	s3ObjectStreamEndOfRange
+	// No more rows are needed by the query
+	s3ObjectStreamLimitReached
+)
+
 func listS3Objects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	// Bucket location will be nil if getBucketLocationForObjects returned an error but
 	// was ignored through ignore_error_codes config arg
@@ -403,6 +447,11 @@ func listS3Objects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 	bucketName := d.EqualsQuals["bucket_name"].GetStringValue()
 
+	keyRange := buildS3ObjectKeyRange(d.EqualsQualString("prefix"), d.Quals["key"])
+	if keyRange.Empty {
+		return nil, nil
+	}
+
 	// default supported max value is 1000 by ListObjectsV2
 	maxItems := int32(1000)
 
@@ -415,45 +464,282 @@ func listS3Objects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		}
 	}
 
+	// The owner is only returned if requested, and costs extra time per page
+	fetchOwner := false
+	for _, column := range d.QueryContext.Columns {
+		if column == "owner" {
+			fetchOwner = true
+		}
+	}
+
 	input := &s3.ListObjectsV2Input{
 		Bucket:     aws.String(bucketName),
 		MaxKeys:    maxItems,
-		FetchOwner: true,
+		FetchOwner: fetchOwner,
+	}
+	if keyRange.StartAfter != "" {
+		input.StartAfter = aws.String(keyRange.StartAfter)
 	}
 
-	equalQuals := d.EqualsQuals
-	if equalQuals["prefix"] != nil {
-		if equalQuals["prefix"].GetStringValue() != "" {
-			input.Prefix = aws.String(equalQuals["prefix"].GetStringValue())
+	parallelism := defaultS3ObjectListParallelism
+	awsSpcConfig := GetConfig(d.Connection)
+	if awsSpcConfig.S3ObjectListParallelism != nil {
+		parallelism = *awsSpcConfig.S3ObjectListParallelism
+	}
+
+	// Sharding costs extra requests to discover prefixes, so only use it when
+	// more than a page of objects may be needed
+	if parallelism <= 1 || maxItems < 1000 {
+		err := listS3ObjectShard(ctx, s3ObjectQueryData{d}, svc, input, keyRange.Prefix, keyRange)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_object.listS3Objects", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	err = listS3ObjectShards(ctx, s3ObjectQueryData{d}, svc, input, keyRange, parallelism)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object.listS3Objects", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listS3ObjectShards discovers the prefixes directly below the requested
+// prefix using a delimiter, and lists each of them concurrently. Objects found
+// at the top level are streamed by the discovery itself. If there is a single
+// prefix and no objects, discovery descends into it instead. A shard stops at
+// the end of the key range, while every shard stops once the limit is reached.
+func listS3ObjectShards(ctx context.Context, d s3ObjectQuery, svc s3.ListObjectsV2APIClient, input *s3.ListObjectsV2Input, keyRange s3ObjectKeyRange, parallelism int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errorCh := make(chan error, 1)
+	semaphore := make(chan struct{}, parallelism)
+
+	reportError := func(err error) {
+		select {
+		case errorCh <- err:
+		default:
 		}
+		cancel()
 	}
 
-	// execute list call
+	prefix := keyRange.Prefix
 	for {
+		discoveryInput := *input
+		discoveryInput.Prefix = aws.String(prefix)
+		discoveryInput.Delimiter = aws.String("/")
+		discoveryInput.ContinuationToken = nil
+
+		// Collect the prefixes of the first page before dispatching, to find
+		// out whether discovery should descend into a single prefix
+		var pending []string
+		descend := ""
+		first := true
+
+		for ctx.Err() == nil {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			output, err := svc.ListObjectsV2(ctx, &discoveryInput)
+			if err != nil {
+				reportError(err)
+				break
+			}
+
+			if first && len(output.Contents) == 0 && len(output.CommonPrefixes) == 1 && output.NextContinuationToken == nil {
+				descend = *output.CommonPrefixes[0].Prefix
+				break
+			}
+			first = false
+
+			result := streamS3Objects(ctx, d, output.Contents, keyRange)
+			if result == s3ObjectStreamLimitReached {
+				cancel()
+				break
+			}
+
+			for _, commonPrefix := range output.CommonPrefixes {
+				pending = append(pending, *commonPrefix.Prefix)
+			}
+			for _, shardPrefix := range pending {
+				if !s3ObjectShardInRange(shardPrefix, keyRange) {
+					continue
+				}
+
+				shardInput := *input
+				shardPrefix := shardPrefix
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					select {
+					case semaphore <- struct{}{}:
+					case <-ctx.Done():
+						return
+					}
+					defer func() { <-semaphore }()
+
+					err := listS3ObjectShard(ctx, d, svc, &shardInput, shardPrefix, keyRange)
+					if err != nil && ctx.Err() == nil {
+						reportError(err)
+					} else if d.RowsRemaining(ctx) == 0 {
+						// Stop the other shards once the limit has been reached
+						cancel()
+					}
+				}()
+			}
+			pending = nil
+
+			// Objects and prefixes are listed in key order, so later pages are
+			// after the end of the key range too
+			if result == s3ObjectStreamEndOfRange || output.NextContinuationToken == nil {
+				break
+			}
+			discoveryInput.ContinuationToken = output.NextContinuationToken
+		}
+
+		if descend == "" || !s3ObjectShardInRange(descend, keyRange) {
+			break
+		}
+		prefix = descend
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errorCh:
+		return err
+	default:
+	}
+
+	return nil
+}
+
+// listS3ObjectShard lists the objects under a prefix until the end of the key
+// range, or until no more rows are needed.
+func listS3ObjectShard(ctx context.Context, d s3ObjectQuery, svc s3.ListObjectsV2APIClient, input *s3.ListObjectsV2Input, prefix string, keyRange s3ObjectKeyRange) error {
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(svc, input)
+	for paginator.HasMorePages() {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
 
-		objects, err := svc.ListObjectsV2(ctx, input)
+		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			plugin.Logger(ctx).Error("aws_s3_object.ListObjectsV2", "api_error", err)
-			return nil, err
+			return err
 		}
 
-		for _, object := range objects.Contents {
-			d.StreamListItem(ctx, object)
+		if streamS3Objects(ctx, d, output.Contents, keyRange) != s3ObjectStreamMore {
+			return nil
+		}
+	}
 
-			// Context may get cancelled due to manual cancellation or if the limit has been reached
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+	return nil
+}
+
+// streamS3Objects streams the objects within the key range, and returns
+// whether the end of the range or the limit has been reached.
+func streamS3Objects(ctx context.Context, d s3ObjectQuery, objects []types.Object, keyRange s3ObjectKeyRange) s3ObjectStreamResult {
+	for _, object := range objects {
+		if keyRange.End != "" {
+			if *object.Key > keyRange.End || (*object.Key == keyRange.End && !keyRange.EndInclusive) {
+				return s3ObjectStreamEndOfRange
 			}
 		}
-		input.ContinuationToken = objects.NextContinuationToken
-		if objects.NextContinuationToken == nil {
-			break
+
+		d.StreamObject(ctx, object)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return s3ObjectStreamLimitReached
+		}
+	}
+	return s3ObjectStreamMore
+}
+
+// s3ObjectShardInRange returns false if no key under the prefix can be within
+// the key range.
+func s3ObjectShardInRange(prefix string, keyRange s3ObjectKeyRange) bool {
+	// Every key under the prefix sorts before StartAfter
+	if prefix < keyRange.StartAfter && !strings.HasPrefix(keyRange.StartAfter, prefix) {
+		return false
+	}
+	// Every key under the prefix sorts after the end of the range
+	if keyRange.End != "" && prefix > keyRange.End {
+		return false
+	}
+	return true
+}
+
+// buildS3ObjectKeyRange pushes down the prefix qual and the key quals, i.e.
+// =, >, >=, <, <= and LIKE 'prefix%', into a prefix and the range of keys to
+// list. Postgres still filters the rows, so the range only has to include
+// every matching key.
+func buildS3ObjectKeyRange(prefix string, keyQuals *plugin.KeyColumnQuals) s3ObjectKeyRange {
+	keyRange := s3ObjectKeyRange{Prefix: prefix}
+
+	// Narrow the prefix, or return an empty range if the prefixes conflict
+	narrowPrefix := func(p string) {
+		switch {
+		case strings.HasPrefix(p, keyRange.Prefix):
+			keyRange.Prefix = p
+		case !strings.HasPrefix(keyRange.Prefix, p):
+			keyRange.Empty = true
 		}
 	}
+	raiseStart := func(startAfter string) {
+		if startAfter > keyRange.StartAfter {
+			keyRange.StartAfter = startAfter
+		}
+	}
+	lowerEnd := func(end string, inclusive bool) {
+		if keyRange.End == "" || end < keyRange.End || (end == keyRange.End && !inclusive) {
+			keyRange.End = end
+			keyRange.EndInclusive = inclusive
+		}
+	}
+
+	if keyQuals != nil {
+		for _, q := range keyQuals.Quals {
+			key := q.Value.GetStringValue()
+			switch q.Operator {
+			case "=":
+				narrowPrefix(key)
+				lowerEnd(key, true)
+			case ">":
+				raiseStart(key)
+			case ">=":
+				// StartAfter is exclusive, so start after a key just before it
+				if key != "" {
+					raiseStart(key[:len(key)-1])
+				}
+			case "<":
+				lowerEnd(key, false)
+			case "<=":
+				lowerEnd(key, true)
+			case "~~":
+				// Only the literal characters before the first wildcard can be
+				// used as a prefix
+				if i := strings.IndexAny(key, "%_\\"); i >= 0 {
+					key = key[:i]
+				}
+				narrowPrefix(key)
+			}
+		}
+	}
+
+	if keyRange.End != "" && keyRange.StartAfter >= keyRange.End {
+		keyRange.Empty = true
+	}
 
-	return nil, err
+	return keyRange
 }
 
 func getS3Object(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -0,0 +1,471 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3ObjectLambdaAccessPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object_lambda_access_point",
+		Description: "AWS S3 Object Lambda Access Point",
+		List: &plugin.ListConfig{
+			Hydrate: listS3ObjectLambdaAccessPoints,
+			Tags:    map[string]string{"service": "s3", "action": "ListAccessPointsForObjectLambda"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "region"}),
+			Hydrate:    getS3ObjectLambdaAccessPoint,
+			Tags:       map[string]string{"service": "s3", "action": "GetAccessPointForObjectLambda"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchAccessPoint", "InvalidParameter", "InvalidRequest"}),
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getS3ObjectLambdaAccessPoint,
+				Tags: map[string]string{"service": "s3", "action": "GetAccessPointForObjectLambda"},
+			},
+			{
+				Func: getS3ObjectLambdaAccessPointConfiguration,
+				Tags: map[string]string{"service": "s3", "action": "GetAccessPointConfigurationForObjectLambda"},
+			},
+			{
+				Func: getS3ObjectLambdaAccessPointPolicyStatus,
+				Tags: map[string]string{"service": "s3", "action": "GetAccessPointPolicyStatusForObjectLambda"},
+			},
+			{
+				Func: getS3ObjectLambdaAccessPointPolicy,
+				Tags: map[string]string{"service": "s3", "action": "GetAccessPointPolicyForObjectLambda"},
+			},
+		},
+		GetMatrixItemFunc: SupportedRegionMatrix(s3controlv1.EndpointsID),
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Object Lambda access point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Object Lambda access point.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectLambdaAccessPointArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time when the Object Lambda access point was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getS3ObjectLambdaAccessPoint,
+			},
+			{
+				Name:        "supporting_access_point",
+				Description: "The ARN of the standard access point used by the Object Lambda access point to access the underlying bucket.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.SupportingAccessPoint"),
+			},
+			{
+				Name:        "cloud_watch_metrics_enabled",
+				Description: "Indicates whether CloudWatch metrics are enabled for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.CloudWatchMetricsEnabled"),
+			},
+			{
+				Name:        "allowed_features",
+				Description: "The features that are allowed for the Lambda function, GetObject-Range or GetObject-PartNumber.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.AllowedFeatures"),
+			},
+			{
+				Name:        "transformation_configurations",
+				Description: "The transformations applied to objects, with the S3 actions they apply to and the Lambda function invoked.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.From(s3ObjectLambdaTransformationConfigurations),
+			},
+			{
+				Name:        "access_point_policy_is_public",
+				Description: "Indicates whether the Object Lambda access point policy is public, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicyStatus,
+				Transform:   transform.FromField("PolicyStatus.IsPublic"),
+				Default:     false,
+			},
+			{
+				Name:        "block_public_acls",
+				Description: "Specifies whether Amazon S3 should block public access control lists (ACLs) for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPoint,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicAcls"),
+			},
+			{
+				Name:        "block_public_policy",
+				Description: "Specifies whether Amazon S3 should block public policies for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPoint,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicPolicy"),
+			},
+			{
+				Name:        "ignore_public_acls",
+				Description: "Specifies whether Amazon S3 should ignore public ACLs for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPoint,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.IgnorePublicAcls"),
+			},
+			{
+				Name:        "restrict_public_buckets",
+				Description: "Specifies whether Amazon S3 should restrict public policies for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPoint,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.RestrictPublicBuckets"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy associated with the Object Lambda access point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(policyToCanonical),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointArn,
+				Transform:   transform.FromValue().Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3ObjectLambdaAccessPoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.listS3ObjectLambdaAccessPoints", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	region := d.EqualsQualString(matrixKeyRegion)
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.listS3ObjectLambdaAccessPoints", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(1000)
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			maxItems = limit
+		}
+	}
+
+	input := &s3control.ListAccessPointsForObjectLambdaInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: maxItems,
+	}
+
+	paginator := s3control.NewListAccessPointsForObjectLambdaPaginator(svc, input, func(o *s3control.ListAccessPointsForObjectLambdaPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.listS3ObjectLambdaAccessPoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, accessPoint := range output.ObjectLambdaAccessPointList {
+			d.StreamListItem(ctx, accessPoint)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3ObjectLambdaAccessPoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	matrixRegion := d.EqualsQualString(matrixKeyRegion)
+
+	var name, region string
+	if h.Item != nil {
+		name = objectLambdaAccessPointName(h.Item)
+		region = matrixRegion
+	} else {
+		name = d.EqualsQuals["name"].GetStringValue()
+		region = d.EqualsQuals["region"].GetStringValue()
+	}
+
+	// Return nil, if given region doesn't match config region
+	if region != matrixRegion {
+		return nil, nil
+	}
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPoint", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, matrixRegion)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPoint", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetAccessPointForObjectLambdaInput{
+		Name:      aws.String(name),
+		AccountId: aws.String(commonColumnData.AccountId),
+	}
+
+	item, err := svc.GetAccessPointForObjectLambda(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPoint", "api_error", err)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func getS3ObjectLambdaAccessPointConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointConfiguration", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		Name:      aws.String(objectLambdaAccessPointName(h.Item)),
+		AccountId: aws.String(commonColumnData.AccountId),
+	}
+
+	op, err := svc.GetAccessPointConfigurationForObjectLambda(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getS3ObjectLambdaAccessPointPolicyStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicyStatus", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicyStatus", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetAccessPointPolicyStatusForObjectLambdaInput{
+		Name:      aws.String(objectLambdaAccessPointName(h.Item)),
+		AccountId: aws.String(commonColumnData.AccountId),
+	}
+
+	op, err := svc.GetAccessPointPolicyStatusForObjectLambda(ctx, params)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NoSuchAccessPointPolicy" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicyStatus", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getS3ObjectLambdaAccessPointPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicy", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create Session
+	svc, err := S3ControlClient(ctx, d, region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicy", "client_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &s3control.GetAccessPointPolicyForObjectLambdaInput{
+		Name:      aws.String(objectLambdaAccessPointName(h.Item)),
+		AccountId: aws.String(commonColumnData.AccountId),
+	}
+
+	op, err := svc.GetAccessPointPolicyForObjectLambda(ctx, params)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NoSuchAccessPointPolicy" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getS3ObjectLambdaAccessPointArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if item, ok := h.Item.(types.ObjectLambdaAccessPoint); ok && item.ObjectLambdaAccessPointArn != nil {
+		return *item.ObjectLambdaAccessPointArn, nil
+	}
+
+	region := d.EqualsQualString(matrixKeyRegion)
+
+	// Get account details
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_lambda_access_point.getS3ObjectLambdaAccessPointArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":s3-object-lambda:" + region + ":" + commonColumnData.AccountId + ":accesspoint/" + objectLambdaAccessPointName(h.Item)
+
+	return arn, nil
+}
+
+func objectLambdaAccessPointName(item interface{}) string {
+	switch item := item.(type) {
+	case types.ObjectLambdaAccessPoint:
+		return *item.Name
+	case *s3control.GetAccessPointForObjectLambdaOutput:
+		return *item.Name
+	}
+	return ""
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The content transformation is a union, so return it keyed by the member that
+// is set, e.g. {"AwsLambda": {"FunctionArn": "..."}}
+func s3ObjectLambdaTransformationConfigurations(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	output := d.HydrateItem.(*s3control.GetAccessPointConfigurationForObjectLambdaOutput)
+	if output.Configuration == nil {
+		return nil, nil
+	}
+
+	configurations := []map[string]interface{}{}
+	for _, c := range output.Configuration.TransformationConfigurations {
+		configuration := map[string]interface{}{
+			"Actions": c.Actions,
+		}
+		if t, ok := c.ContentTransformation.(*types.ObjectLambdaContentTransformationMemberAwsLambda); ok {
+			configuration["ContentTransformation"] = map[string]interface{}{"AwsLambda": t.Value}
+		}
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations, nil
+}
@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3ObjectRecord(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object_record",
+		Description: "Read the contents of AWS S3 objects as rows, one per line or CSV/JSON Lines record.",
+		List: &plugin.ListConfig{
+			Hydrate: listS3ObjectRecords,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "prefix", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "key", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "format", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "csv_has_header", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getBucketLocationForObjects,
+				Tags: map[string]string{"service": "s3", "action": "GetBucketLocation"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("bucket_name"),
+			},
+			{
+				Name:        "prefix",
+				Description: "The prefix of the keys of the objects to read.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("prefix"),
+			},
+			{
+				Name:        "key",
+				Description: "The key of the object the row was read from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "format",
+				Description: "The format the object was read as, csv, tsv, jsonl or text. Defaults to the format matching the key extension, otherwise text.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("format").TransformP(qualValueOrField, "Format"),
+			},
+			{
+				Name:        "csv_has_header",
+				Description: "True if the first line of a CSV object is a header. Detected from the first line if not specified.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "line_number",
+				Description: "The line of the object the row starts on, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "line",
+				Description: "The text of the line, for text and JSON Lines objects.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "record",
+				Description: "The parsed record, for CSV and JSON Lines objects. CSV records are keyed by header name if the object has a header, otherwise they are an array of fields.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+type s3ObjectRecord struct {
+	Key          *string
+	Format       string
+	CsvHasHeader *bool
+	LineNumber   int
+	Line         *string
+	Record       interface{}
+}
+
+//// LIST FUNCTION
+
+func listS3ObjectRecords(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Bucket location will be nil if getBucketLocationForObjects returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocationForObjects(ctx, d, h)
+	if err != nil {
+		return nil, err
+	} else if location == "" {
+		return nil, nil
+	}
+
+	svc, err := S3Client(ctx, d, fmt.Sprint(location))
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_record.listS3ObjectRecords", "get_client_error", err)
+		return nil, err
+	}
+
+	bucketName := d.EqualsQualString("bucket_name")
+
+	if key := d.EqualsQualString("key"); key != "" {
+		if _, err := readS3ObjectRecords(ctx, d, svc, bucketName, key); err != nil {
+			plugin.Logger(ctx).Error("aws_s3_object_record.listS3ObjectRecords", "key", key, "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if d.EqualsQualString("prefix") != "" {
+		input.Prefix = aws.String(d.EqualsQualString("prefix"))
+	}
+
+	paginator := s3.NewListObjectsV2Paginator(svc, input)
+	for paginator.HasMorePages() {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_s3_object_record.listS3ObjectRecords", "api_error", err)
+			return nil, err
+		}
+
+		for _, object := range output.Contents {
+			// Skip the placeholder objects created for folders in the console
+			if strings.HasSuffix(*object.Key, "/") {
+				continue
+			}
+			more, err := readS3ObjectRecords(ctx, d, svc, bucketName, *object.Key)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_s3_object_record.listS3ObjectRecords", "key", *object.Key, "api_error", err)
+				return nil, err
+			}
+			if !more {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// readS3ObjectRecords streams the rows of a single object, returning false
+// once no more rows are needed.
+func readS3ObjectRecords(ctx context.Context, d *plugin.QueryData, svc *s3.Client, bucketName string, key string) (bool, error) {
+	object, err := svc.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return false, err
+	}
+	defer object.Body.Close()
+
+	reader, err := newS3ObjectContentReader(object.Body)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	format := s3ObjectRecordFormat(key)
+	if d.EqualsQualString("format") != "" {
+		format = strings.ToLower(d.EqualsQualString("format"))
+	}
+
+	switch format {
+	case "csv", "tsv":
+		return readS3ObjectCsvRecords(ctx, d, reader, key, format)
+	case "jsonl", "text":
+		return readS3ObjectLineRecords(ctx, d, reader, key, format)
+	}
+
+	return false, fmt.Errorf("unsupported format %q, must be one of csv, tsv, jsonl or text", format)
+}
+
+func readS3ObjectCsvRecords(ctx context.Context, d *plugin.QueryData, reader io.Reader, key string, format string) (bool, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	if format == "tsv" {
+		csvReader.Comma = '\t'
+	}
+
+	var header []string
+	for first := true; ; first = false {
+		fields, err := csvReader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if first {
+			hasHeader := isCsvHeader(fields)
+			if d.EqualsQuals["csv_has_header"] != nil {
+				hasHeader = d.EqualsQuals["csv_has_header"].GetBoolValue()
+			}
+			if hasHeader {
+				header = fields
+				continue
+			}
+		}
+
+		var record interface{} = fields
+		if header != nil {
+			row := map[string]interface{}{}
+			for i, value := range fields {
+				if i < len(header) {
+					row[header[i]] = value
+				} else {
+					row[strconv.Itoa(i+1)] = value
+				}
+			}
+			record = row
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		d.StreamListItem(ctx, &s3ObjectRecord{
+			Key:          aws.String(key),
+			Format:       format,
+			CsvHasHeader: aws.Bool(header != nil),
+			LineNumber:   line,
+			Record:       record,
+		})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return false, nil
+		}
+	}
+}
+
+func readS3ObjectLineRecords(ctx context.Context, d *plugin.QueryData, reader io.Reader, key string, format string) (bool, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		item := &s3ObjectRecord{
+			Key:        aws.String(key),
+			Format:     format,
+			LineNumber: lineNumber,
+			Line:       aws.String(line),
+		}
+		if format == "jsonl" {
+			// Skip blank lines between records
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			var record interface{}
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				plugin.Logger(ctx).Debug("aws_s3_object_record.readS3ObjectLineRecords", "key", key, "line_number", lineNumber, "unmarshal_error", err)
+			} else {
+				item.Record = record
+			}
+		}
+
+		d.StreamListItem(ctx, item)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return false, nil
+		}
+	}
+
+	return true, scanner.Err()
+}
+
+//// UTILITY FUNCTIONS
+
+// s3ObjectRecordFormat returns the format matching the key extension, ignoring
+// any compression extension.
+func s3ObjectRecordFormat(key string) string {
+	lowerKey := strings.ToLower(key)
+	for _, ext := range []string{".gz", ".gzip", ".zst", ".zstd"} {
+		lowerKey = strings.TrimSuffix(lowerKey, ext)
+	}
+
+	switch {
+	case strings.HasSuffix(lowerKey, ".csv"):
+		return "csv"
+	case strings.HasSuffix(lowerKey, ".tsv"):
+		return "tsv"
+	case strings.HasSuffix(lowerKey, ".jsonl"), strings.HasSuffix(lowerKey, ".ndjson"), strings.HasSuffix(lowerKey, ".json"):
+		return "jsonl"
+	}
+	return "text"
+}
+
+// isCsvHeader guesses whether the first row of a CSV object is a header: every
+// field is a unique, non-empty value that isn't a number.
+func isCsvHeader(fields []string) bool {
+	seen := map[string]bool{}
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" || seen[field] {
+			return false
+		}
+		if _, err := strconv.ParseFloat(field, 64); err == nil {
+			return false
+		}
+		seen[field] = true
+	}
+	return len(fields) > 0
+}
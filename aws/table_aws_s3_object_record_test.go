@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestS3ObjectRecordFormat(t *testing.T) {
+	cases := map[string]string{
+		"exports/users.csv":         "csv",
+		"exports/users.CSV.gz":      "csv",
+		"exports/hosts.tsv.zst":     "tsv",
+		"events/2023/10/10.jsonl":   "jsonl",
+		"events/2023/10/10.json.gz": "jsonl",
+		"config/settings.yaml":      "text",
+		"logs/app.log":              "text",
+	}
+	for key, expected := range cases {
+		if format := s3ObjectRecordFormat(key); format != expected {
+			t.Errorf("%s: expected %s, got %s", key, expected, format)
+		}
+	}
+}
+
+func TestIsCsvHeader(t *testing.T) {
+	cases := []struct {
+		fields   []string
+		expected bool
+	}{
+		{[]string{"id", "email", "status"}, true},
+		{[]string{"1", "alice@example.com", "active"}, false},
+		{[]string{"id", "", "status"}, false},
+		{[]string{"name", "name"}, false},
+		{[]string{}, false},
+	}
+	for _, c := range cases {
+		if isCsvHeader(c.fields) != c.expected {
+			t.Errorf("%v: expected %t", c.fields, c.expected)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3ObjectSelect(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object_select",
+		Description: "Query the contents of a CSV, JSON or Parquet object in an AWS S3 bucket using S3 Select.",
+		List: &plugin.ListConfig{
+			Hydrate: listS3ObjectSelectRecords,
+			Tags:    map[string]string{"service": "s3", "action": "GetObject"},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "bucket_name", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "key", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "expression", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "input_format", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "compression_type", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "csv_file_header_info", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "csv_field_delimiter", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "json_type", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsAccountColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("bucket_name"),
+			},
+			{
+				Name:        "key",
+				Description: "The key of the object to query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("key"),
+			},
+			{
+				Name:        "expression",
+				Description: "The S3 Select SQL expression used to query the object, e.g. select * from s3object s where s.status = 'active'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("expression"),
+			},
+			{
+				Name:        "input_format",
+				Description: "The format of the object, CSV, JSON or Parquet. Defaults to the format matching the key extension, otherwise CSV.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("input_format"),
+			},
+			{
+				Name:        "compression_type",
+				Description: "The compression of a CSV or JSON object, NONE, GZIP or BZIP2. Defaults to the compression matching the key extension, otherwise NONE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("compression_type"),
+			},
+			{
+				Name:        "csv_file_header_info",
+				Description: "Describes the first line of a CSV object. USE to reference columns by header name, IGNORE to skip it or NONE if there is no header. Defaults to USE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("csv_file_header_info"),
+			},
+			{
+				Name:        "csv_field_delimiter",
+				Description: "The character used to separate fields in a CSV object. Defaults to a comma.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("csv_field_delimiter"),
+			},
+			{
+				Name:        "json_type",
+				Description: "The type of a JSON object, LINES for JSON Lines or DOCUMENT for a single JSON document. Defaults to LINES.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("json_type"),
+			},
+			{
+				Name:        "row_number",
+				Description: "The position of the record in the results, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "record",
+				Description: "The record returned by the expression.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "bytes_scanned",
+				Description: "The number of object bytes scanned when the record was returned.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Progress.BytesScanned"),
+			},
+			{
+				Name:        "bytes_processed",
+				Description: "The number of uncompressed object bytes processed when the record was returned.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Progress.BytesProcessed"),
+			},
+			{
+				Name:        "bytes_returned",
+				Description: "The number of bytes of records returned when the record was returned.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Progress.BytesReturned"),
+			},
+		}),
+	}
+}
+
+type s3ObjectSelectRecord struct {
+	RowNumber int
+	Record    interface{}
+	Progress  *types.Progress
+}
+
+//// LIST FUNCTION
+
+func listS3ObjectSelectRecords(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Bucket location will be nil if getBucketLocationForObjects returned an error but
+	// was ignored through ignore_error_codes config arg
+	location, err := getBucketLocationForObjects(ctx, d, h)
+	if err != nil {
+		return nil, err
+	} else if location == "" {
+		return nil, nil
+	}
+
+	svc, err := S3Client(ctx, d, fmt.Sprint(location))
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_select.listS3ObjectSelectRecords", "get_client_error", err)
+		return nil, err
+	}
+
+	key := d.EqualsQualString("key")
+	inputSerialization, err := buildS3SelectInputSerialization(key, d.EqualsQuals)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.SelectObjectContentInput{
+		Bucket:             aws.String(d.EqualsQualString("bucket_name")),
+		Key:                aws.String(key),
+		Expression:         aws.String(d.EqualsQualString("expression")),
+		ExpressionType:     types.ExpressionTypeSql,
+		InputSerialization: inputSerialization,
+		// Records are returned as JSON Lines regardless of the input format
+		OutputSerialization: &types.OutputSerialization{
+			JSON: &types.JSONOutput{RecordDelimiter: aws.String("\n")},
+		},
+		RequestProgress: &types.RequestProgress{Enabled: true},
+	}
+
+	rowNumber := 0
+	err = streamS3SelectRecords(ctx, svc, input, func(line []byte, progress *types.Progress) (bool, error) {
+		var record interface{}
+		if err := json.Unmarshal(line, &record); err != nil {
+			return false, err
+		}
+		rowNumber++
+		d.StreamListItem(ctx, &s3ObjectSelectRecord{
+			RowNumber: rowNumber,
+			Record:    record,
+			Progress:  progress,
+		})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		return d.RowsRemaining(ctx) != 0, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_s3_object_select.listS3ObjectSelectRecords", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// streamS3SelectRecords runs an S3 Select request with JSON Lines output and
+// calls fn with each record and the latest progress of the scan. Reading
+// stops as soon as fn returns false or an error.
+func streamS3SelectRecords(ctx context.Context, svc *s3.Client, input *s3.SelectObjectContentInput, fn func(record []byte, progress *types.Progress) (bool, error)) error {
+	output, err := svc.SelectObjectContent(ctx, input)
+	if err != nil {
+		return err
+	}
+	stream := output.GetStream()
+	defer stream.Close()
+
+	// A records event can end part way through a record, so keep the
+	// remainder until the next event
+	var pending []byte
+	progress := &types.Progress{}
+
+	for event := range stream.Events() {
+		switch e := event.(type) {
+		case *types.SelectObjectContentEventStreamMemberProgress:
+			if e.Value.Details != nil {
+				progress = e.Value.Details
+			}
+		case *types.SelectObjectContentEventStreamMemberStats:
+			if e.Value.Details != nil {
+				progress = &types.Progress{
+					BytesProcessed: e.Value.Details.BytesProcessed,
+					BytesReturned:  e.Value.Details.BytesReturned,
+					BytesScanned:   e.Value.Details.BytesScanned,
+				}
+			}
+		case *types.SelectObjectContentEventStreamMemberRecords:
+			pending = append(pending, e.Value.Payload...)
+			for {
+				i := bytes.IndexByte(pending, '\n')
+				if i < 0 {
+					break
+				}
+				line := pending[:i]
+				pending = pending[i+1:]
+				if len(bytes.TrimSpace(line)) == 0 {
+					continue
+				}
+				more, err := fn(line, progress)
+				if err != nil || !more {
+					return err
+				}
+			}
+		}
+	}
+
+	return stream.Err()
+}
+
+// buildS3SelectInputSerialization describes the object to S3 Select from the
+// input quals, falling back to the format and compression matching the key
+// extension, e.g. data.csv.gz is read as gzipped CSV.
+func buildS3SelectInputSerialization(key string, equalQuals plugin.KeyColumnEqualsQualMap) (*types.InputSerialization, error) {
+	lowerKey := strings.ToLower(key)
+
+	compressionType := types.CompressionTypeNone
+	switch {
+	case strings.HasSuffix(lowerKey, ".gz"):
+		compressionType = types.CompressionTypeGzip
+		lowerKey = strings.TrimSuffix(lowerKey, ".gz")
+	case strings.HasSuffix(lowerKey, ".bz2"):
+		compressionType = types.CompressionTypeBzip2
+		lowerKey = strings.TrimSuffix(lowerKey, ".bz2")
+	}
+	if equalQuals["compression_type"] != nil {
+		compressionType = types.CompressionType(strings.ToUpper(equalQuals["compression_type"].GetStringValue()))
+	}
+
+	inputFormat := "CSV"
+	switch {
+	case strings.HasSuffix(lowerKey, ".json"), strings.HasSuffix(lowerKey, ".jsonl"), strings.HasSuffix(lowerKey, ".ndjson"):
+		inputFormat = "JSON"
+	case strings.HasSuffix(lowerKey, ".parquet"):
+		inputFormat = "PARQUET"
+	}
+	if equalQuals["input_format"] != nil {
+		inputFormat = strings.ToUpper(equalQuals["input_format"].GetStringValue())
+	}
+
+	inputSerialization := &types.InputSerialization{}
+	switch inputFormat {
+	case "CSV":
+		inputSerialization.CompressionType = compressionType
+		inputSerialization.CSV = &types.CSVInput{
+			FileHeaderInfo: types.FileHeaderInfoUse,
+		}
+		if equalQuals["csv_file_header_info"] != nil {
+			inputSerialization.CSV.FileHeaderInfo = types.FileHeaderInfo(strings.ToUpper(equalQuals["csv_file_header_info"].GetStringValue()))
+		}
+		if equalQuals["csv_field_delimiter"] != nil {
+			inputSerialization.CSV.FieldDelimiter = aws.String(equalQuals["csv_field_delimiter"].GetStringValue())
+		}
+	case "JSON":
+		inputSerialization.CompressionType = compressionType
+		inputSerialization.JSON = &types.JSONInput{
+			Type: types.JSONTypeLines,
+		}
+		if equalQuals["json_type"] != nil {
+			inputSerialization.JSON.Type = types.JSONType(strings.ToUpper(equalQuals["json_type"].GetStringValue()))
+		}
+	case "PARQUET":
+		// Parquet objects are compressed internally, so S3 Select only accepts
+		// a compression type of NONE
+		inputSerialization.Parquet = &types.ParquetInput{}
+	default:
+		return nil, fmt.Errorf("unsupported input_format %q, must be one of CSV, JSON or Parquet", inputFormat)
+	}
+
+	return inputSerialization, nil
+}
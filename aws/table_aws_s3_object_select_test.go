@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func s3SelectStringQuals(columnValues ...string) plugin.KeyColumnEqualsQualMap {
+	equalQuals := plugin.KeyColumnEqualsQualMap{}
+	for i := 0; i < len(columnValues); i += 2 {
+		equalQuals[columnValues[i]] = &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: columnValues[i+1]}}
+	}
+	return equalQuals
+}
+
+func TestBuildS3SelectInputSerialization(t *testing.T) {
+	cases := []struct {
+		name            string
+		key             string
+		quals           plugin.KeyColumnEqualsQualMap
+		compressionType types.CompressionType
+		fileHeaderInfo  types.FileHeaderInfo
+		fieldDelimiter  string
+		jsonType        types.JSONType
+		parquet         bool
+	}{
+		{"csv from key", "data/users.csv", nil, types.CompressionTypeNone, types.FileHeaderInfoUse, "", "", false},
+		{"gzip csv from key", "data/users.CSV.GZ", nil, types.CompressionTypeGzip, types.FileHeaderInfoUse, "", "", false},
+		{"csv quals", "data/users.tsv", s3SelectStringQuals("csv_file_header_info", "ignore", "csv_field_delimiter", "\t"), types.CompressionTypeNone, types.FileHeaderInfoIgnore, "\t", "", false},
+		{"compression qual", "data/users.csv", s3SelectStringQuals("compression_type", "bzip2"), types.CompressionTypeBzip2, types.FileHeaderInfoUse, "", "", false},
+		{"json lines from key", "logs/events.jsonl.bz2", nil, types.CompressionTypeBzip2, "", "", types.JSONTypeLines, false},
+		{"json document qual", "logs/events.json", s3SelectStringQuals("json_type", "document"), types.CompressionTypeNone, "", "", types.JSONTypeDocument, false},
+		{"json input format qual", "logs/events.txt.gz", s3SelectStringQuals("input_format", "json", "json_type", "lines"), types.CompressionTypeGzip, "", "", types.JSONTypeLines, false},
+		{"parquet from key", "data/users.parquet", nil, "", "", "", "", true},
+		{"parquet ignores compression", "data/users.parquet", s3SelectStringQuals("compression_type", "gzip"), "", "", "", "", true},
+	}
+	for _, c := range cases {
+		input, err := buildS3SelectInputSerialization(c.key, c.quals)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if input.CompressionType != c.compressionType {
+			t.Errorf("%s: expected compression type %q, got %q", c.name, c.compressionType, input.CompressionType)
+		}
+		if (input.Parquet != nil) != c.parquet {
+			t.Errorf("%s: expected parquet %v, got %+v", c.name, c.parquet, input.Parquet)
+		}
+		switch {
+		case c.fileHeaderInfo != "":
+			if input.CSV == nil || input.CSV.FileHeaderInfo != c.fileHeaderInfo {
+				t.Errorf("%s: expected CSV input with header info %q, got %+v", c.name, c.fileHeaderInfo, input.CSV)
+			} else if (c.fieldDelimiter == "" && input.CSV.FieldDelimiter != nil) || (c.fieldDelimiter != "" && (input.CSV.FieldDelimiter == nil || *input.CSV.FieldDelimiter != c.fieldDelimiter)) {
+				t.Errorf("%s: expected field delimiter %q, got %v", c.name, c.fieldDelimiter, input.CSV.FieldDelimiter)
+			}
+		case c.jsonType != "":
+			if input.JSON == nil || input.JSON.Type != c.jsonType {
+				t.Errorf("%s: expected JSON input of type %q, got %+v", c.name, c.jsonType, input.JSON)
+			}
+		}
+	}
+
+	if _, err := buildS3SelectInputSerialization("data/users.csv", s3SelectStringQuals("input_format", "xml")); err == nil {
+		t.Errorf("expected an error for an unsupported input format")
+	}
+}
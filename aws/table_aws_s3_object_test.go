@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+)
+
+func s3ObjectKeyQuals(operatorValues ...string) *plugin.KeyColumnQuals {
+	keyQuals := &plugin.KeyColumnQuals{Name: "key"}
+	for i := 0; i < len(operatorValues); i += 2 {
+		keyQuals.Quals = append(keyQuals.Quals, &quals.Qual{
+			Column:   "key",
+			Operator: operatorValues[i],
+			Value:    &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: operatorValues[i+1]}},
+		})
+	}
+	return keyQuals
+}
+
+func TestBuildS3ObjectKeyRange(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefix   string
+		keyQuals *plugin.KeyColumnQuals
+		expected s3ObjectKeyRange
+	}{
+		{"no quals", "logs/", nil, s3ObjectKeyRange{Prefix: "logs/"}},
+		{"equals", "", s3ObjectKeyQuals("=", "logs/a.txt"), s3ObjectKeyRange{Prefix: "logs/a.txt", End: "logs/a.txt", EndInclusive: true}},
+		{"range", "", s3ObjectKeyQuals(">=", "logs/2023", "<", "logs/2024"), s3ObjectKeyRange{StartAfter: "logs/202", End: "logs/2024"}},
+		{"like", "logs/", s3ObjectKeyQuals("~~", "logs/2023/%.gz"), s3ObjectKeyRange{Prefix: "logs/2023/"}},
+		{"like with single character wildcard", "", s3ObjectKeyQuals("~~", "logs/202_/%"), s3ObjectKeyRange{Prefix: "logs/202"}},
+		{"conflicting prefixes", "logs/", s3ObjectKeyQuals("~~", "data/%"), s3ObjectKeyRange{Prefix: "logs/", Empty: true}},
+		{"empty range", "", s3ObjectKeyQuals(">", "b", "<", "a"), s3ObjectKeyRange{StartAfter: "b", End: "a", Empty: true}},
+	}
+	for _, c := range cases {
+		if keyRange := buildS3ObjectKeyRange(c.prefix, c.keyQuals); keyRange != c.expected {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, keyRange)
+		}
+	}
+}
+
+func TestS3ObjectShardInRange(t *testing.T) {
+	keyRange := s3ObjectKeyRange{StartAfter: "logs/2023/06", End: "logs/2024"}
+	cases := map[string]bool{
+		"logs/2022/":    false,
+		"logs/2023/":    true,
+		"logs/2023/07/": true,
+		"logs/2024/":    false,
+		"data/":         false,
+	}
+	for prefix, expected := range cases {
+		if inRange := s3ObjectShardInRange(prefix, keyRange); inRange != expected {
+			t.Errorf("%s: expected %t, got %t", prefix, expected, inRange)
+		}
+	}
+}
+
+// fakeS3ObjectBucket lists its keys in a single page, grouping them by the
+// delimiter like ListObjectsV2.
+type fakeS3ObjectBucket []string
+
+func (b fakeS3ObjectBucket) ListObjectsV2(_ context.Context, input *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	prefix, delimiter := aws.ToString(input.Prefix), aws.ToString(input.Delimiter)
+	output := &s3.ListObjectsV2Output{}
+	seen := map[string]bool{}
+	for _, key := range b {
+		if !strings.HasPrefix(key, prefix) || key <= aws.ToString(input.StartAfter) {
+			continue
+		}
+		if i := strings.Index(key[len(prefix):], delimiter); delimiter != "" && i >= 0 {
+			commonPrefix := key[:len(prefix)+i+1]
+			if !seen[commonPrefix] {
+				seen[commonPrefix] = true
+				output.CommonPrefixes = append(output.CommonPrefixes, types.CommonPrefix{Prefix: aws.String(commonPrefix)})
+			}
+			continue
+		}
+		output.Contents = append(output.Contents, types.Object{Key: aws.String(key)})
+	}
+	return output, nil
+}
+
+// fakeS3ObjectQuery collects the streamed keys until the limit is reached.
+type fakeS3ObjectQuery struct {
+	mu    sync.Mutex
+	limit int64
+	keys  []string
+}
+
+func (q *fakeS3ObjectQuery) WaitForListRateLimit(context.Context) {}
+
+func (q *fakeS3ObjectQuery) RowsRemaining(ctx context.Context) int64 {
+	if ctx.Err() != nil {
+		return 0
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit - int64(len(q.keys))
+}
+
+func (q *fakeS3ObjectQuery) StreamObject(_ context.Context, object types.Object) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.keys = append(q.keys, *object.Key)
+}
+
+func TestListS3ObjectShards(t *testing.T) {
+	bucket := fakeS3ObjectBucket{"a/1.txt", "a/2.txt", "b/1.txt", "b/2.txt", "m/1.txt", "z.txt"}
+	cases := []struct {
+		name     string
+		keyQuals *plugin.KeyColumnQuals
+		expected []string
+	}{
+		{"no quals", nil, []string(bucket)},
+		// The root object after the end of the range must not stop the shards
+		{"less than", s3ObjectKeyQuals("<", "m"), []string{"a/1.txt", "a/2.txt", "b/1.txt", "b/2.txt"}},
+		// The end of the range in one shard must not stop the other shards
+		{"less than within a shard", s3ObjectKeyQuals("<", "b/2.txt"), []string{"a/1.txt", "a/2.txt", "b/1.txt"}},
+		{"range", s3ObjectKeyQuals(">", "a/1.txt", "<=", "b/1.txt"), []string{"a/2.txt", "b/1.txt"}},
+	}
+	for _, c := range cases {
+		query := &fakeS3ObjectQuery{limit: 1000}
+		keyRange := buildS3ObjectKeyRange("", c.keyQuals)
+		input := &s3.ListObjectsV2Input{Bucket: aws.String("my-bucket")}
+		if keyRange.StartAfter != "" {
+			input.StartAfter = aws.String(keyRange.StartAfter)
+		}
+
+		if err := listS3ObjectShards(context.Background(), query, bucket, input, keyRange, 2); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		sort.Strings(query.keys)
+		if !reflect.DeepEqual(query.keys, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, query.keys)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSavingsPlansSavingsPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_savingsplans_savings_plan",
+		Description: "AWS Savings Plans Savings Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("savings_plan_id"),
+			Hydrate:    getSavingsPlansSavingsPlan,
+			Tags:       map[string]string{"service": "savingsplans", "action": "DescribeSavingsPlans"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlansSavingsPlans,
+			Tags:    map[string]string{"service": "savingsplans", "action": "DescribeSavingsPlans"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		Columns: awsGlobalRegionColumns([]*plugin.Column{
+			{
+				Name:        "savings_plan_id",
+				Description: "The ID of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the Savings Plan. Possible values are: payment-pending, payment-failed, active, retired, queued, queued-deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_type",
+				Description: "The plan type. Possible values are: Compute, EC2Instance, SageMaker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The payment option for the Savings Plan. Possible values are: All Upfront, Partial Upfront, No Upfront.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_region",
+				Description: "The AWS Region of an EC2 Instance Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "ec2_instance_family",
+				Description: "The EC2 instance family of an EC2 Instance Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commitment",
+				Description: "The hourly commitment, in USD.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "upfront_payment_amount",
+				Description: "The up-front payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_payment_amount",
+				Description: "The recurring payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "term_duration_in_seconds",
+				Description: "The duration of the term, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "start",
+				Description: "The start time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end",
+				Description: "The end time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "offering_id",
+				Description: "The ID of the offering.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_types",
+				Description: "The product types.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Savings Plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SavingsPlanArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlansSavingsPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SavingsPlansClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplans_savings_plan.listSavingsPlansSavingsPlans", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &savingsplans.DescribeSavingsPlansInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if state := d.EqualsQualString("state"); state != "" {
+		input.States = []types.SavingsPlanState{types.SavingsPlanState(state)}
+	}
+
+	for {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		output, err := svc.DescribeSavingsPlans(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_savingsplans_savings_plan.listSavingsPlansSavingsPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.SavingsPlans {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil || *output.NextToken == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSavingsPlansSavingsPlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("savings_plan_id")
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SavingsPlansClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplans_savings_plan.getSavingsPlansSavingsPlan", "client_error", err)
+		return nil, err
+	}
+
+	output, err := svc.DescribeSavingsPlans(ctx, &savingsplans.DescribeSavingsPlansInput{
+		SavingsPlanIds: []string{id},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplans_savings_plan.getSavingsPlansSavingsPlan", "api_error", err)
+		return nil, err
+	}
+
+	if len(output.SavingsPlans) > 0 {
+		return output.SavingsPlans[0], nil
+	}
+	return nil, nil
+}
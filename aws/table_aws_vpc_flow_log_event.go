@@ -5,8 +5,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
-
 	cloudwatchlogsv1 "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -48,7 +46,7 @@ func tableAwsVpcFlowLogEvent(_ context.Context) *plugin.Table {
 		GetMatrixItemFunc: SupportedRegionMatrix(cloudwatchlogsv1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			// Top columns
-			{Name: "log_group_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("log_group_name"), Description: "The name of the log group to which this event belongs."},
+			{Name: "log_group_name", Type: proto.ColumnType_STRING, Description: "The name of the log group to which this event belongs."},
 			{Name: "log_stream_name", Type: proto.ColumnType_STRING, Description: "The name of the log stream to which this event belongs."},
 			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(transform.UnixMsToTimestamp), Description: "The time when the event occurred."},
 			{Name: "version", Type: proto.ColumnType_INT, Hydrate: getMessageField, Transform: transform.FromValue().TransformP(getField, 0), Description: "The VPC Flow Logs version. If you use the default format, the version is 2. If you use a custom format, the version is the highest version among the specified fields. For example, if you specify only fields from version 2, the version is 2. If you specify a mixture of fields from versions 2, 3, and 4, the version is 4."},
@@ -74,7 +72,7 @@ func tableAwsVpcFlowLogEvent(_ context.Context) *plugin.Table {
 }
 
 func getMessageField(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	e := h.Item.(types.FilteredLogEvent)
+	e := h.Item.(cloudwatchLogEvent)
 	fields := strings.Fields(*e.Message)
 	return fields, nil
 }
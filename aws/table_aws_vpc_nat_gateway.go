@@ -35,6 +35,13 @@ func tableAwsVpcNatGateway(_ context.Context) *plugin.Table {
 				{Name: "vpc_id", Require: plugin.Optional},
 			},
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:         getVpcNatGatewayEstimatedCost,
+				Tags:         map[string]string{"service": "pricing", "action": "GetProducts"},
+				IgnoreConfig: pricingIndexIgnoreConfig,
+			},
+		},
 		GetMatrixItemFunc: SupportedRegionMatrix(ec2v1.EndpointsID),
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
@@ -95,6 +102,18 @@ func tableAwsVpcNatGateway(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ProvisionedBandwidth"),
 			},
+			{
+				Name:        "estimated_hourly_cost",
+				Description: "The estimated hourly cost of the NAT gateway in its region, from the public price list. Zero once the NAT gateway is deleted, and null if the price is unknown. Data processing charges are not included.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getVpcNatGatewayEstimatedCost,
+			},
+			{
+				Name:        "estimated_monthly_cost",
+				Description: "The estimated monthly cost of the NAT gateway, i.e. 730 times the estimated hourly cost.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getVpcNatGatewayEstimatedCost,
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags that are attached to NAT gateway.",
@@ -240,6 +259,19 @@ func getVpcNatGatewayARN(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	return arn, nil
 }
 
+func getVpcNatGatewayEstimatedCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.EqualsQualString(matrixKeyRegion)
+	natGateway := h.Item.(types.NatGateway)
+
+	index, err := getPricingIndex(ctx, d, "AmazonEC2", region)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_nat_gateway.getVpcNatGatewayEstimatedCost", "pricing_index_error", err)
+		return nil, err
+	}
+
+	return estimateVpcNatGatewayCost(index, string(natGateway.State)), nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getVpcNatGatewayTurbotData(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -46,6 +46,17 @@ func convertTimestamp(_ context.Context, d *transform.TransformData) (interface{
 	return nil, nil
 }
 
+// qualValueOrField returns the qual value set by a preceding FromQual
+// transform, as written in the query, or else the value of the field named by
+// the param. Columns whose qual is normalized before use return the qual as
+// written, so Postgres still matches the rows against the qual.
+func qualValueOrField(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value != nil {
+		return d.Value, nil
+	}
+	return transform.FieldValue(ctx, d)
+}
+
 func extractNameFromSqsQueueURL(queue string) (string, error) {
 	//http://sqs.us-west-2.amazonaws.com/123456789012/queueName
 	u, err := url.Parse(queue)